@@ -0,0 +1,37 @@
+package match
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func failureMessage(explanation string, msgAndArgs []any) string {
+	if len(msgAndArgs) == 0 {
+		return explanation
+	}
+	var msg string
+	if format, ok := msgAndArgs[0].(string); ok {
+		msg = fmt.Sprintf(format, msgAndArgs[1:]...)
+	} else {
+		msg = strings.TrimSuffix(fmt.Sprintln(msgAndArgs...), "\n")
+	}
+	return msg + "\n" + explanation
+}
+
+func Expect[T any](t testing.TB, got T, matcher Matcher[T], msgAndArgs ...any) bool {
+	t.Helper()
+	result := Check(got, matcher)
+	if !result.Matched() {
+		t.Error(failureMessage(result.Explanation(), msgAndArgs))
+	}
+	return result.Matched()
+}
+
+func Assert[T any](t testing.TB, got T, matcher Matcher[T], msgAndArgs ...any) {
+	t.Helper()
+	result := Check(got, matcher)
+	if !result.Matched() {
+		t.Fatal(failureMessage(result.Explanation(), msgAndArgs))
+	}
+}
@@ -0,0 +1,131 @@
+package match_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/krelinga/go-match"
+)
+
+type fakeTB struct {
+	testing.TB
+	helperCalls int
+	errors      []string
+	fatals      []string
+}
+
+func (f *fakeTB) Helper() {
+	f.helperCalls++
+}
+
+func (f *fakeTB) Error(args ...any) {
+	for _, arg := range args {
+		f.errors = append(f.errors, arg.(string))
+	}
+}
+
+func (f *fakeTB) Fatal(args ...any) {
+	for _, arg := range args {
+		f.fatals = append(f.fatals, arg.(string))
+	}
+}
+
+func TestExpect(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name       string
+		matcher    match.Matcher[int]
+		value      int
+		msgAndArgs []any
+		want       bool
+	}{
+		{
+			name:    "matches",
+			matcher: match.Equal(42),
+			value:   42,
+			want:    true,
+		},
+		{
+			name:    "does_not_match",
+			matcher: match.Equal(42),
+			value:   43,
+			want:    false,
+		},
+		{
+			name:       "does_not_match_with_message",
+			matcher:    match.Equal(42),
+			value:      43,
+			msgAndArgs: []any{"answer for %s", "everything"},
+			want:       false,
+		},
+		{
+			name:       "does_not_match_with_non_format_message",
+			matcher:    match.Equal(42),
+			value:      43,
+			msgAndArgs: []any{42, "is the answer"},
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := &fakeTB{}
+			got := match.Expect(tb, tt.value, tt.matcher, tt.msgAndArgs...)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if tb.helperCalls == 0 {
+				t.Error("expected Helper() to be called")
+			}
+			if len(tb.fatals) != 0 {
+				t.Errorf("expected no fatal failures, got %q", tb.fatals)
+			}
+			goldie.Assert(t, tt.name, []byte(strings.Join(tb.errors, "\n")))
+		})
+	}
+}
+
+func TestAssert(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name       string
+		matcher    match.Matcher[int]
+		value      int
+		msgAndArgs []any
+		wantFatal  bool
+	}{
+		{
+			name:    "matches",
+			matcher: match.Equal(42),
+			value:   42,
+		},
+		{
+			name:      "does_not_match",
+			matcher:   match.Equal(42),
+			value:     43,
+			wantFatal: true,
+		},
+		{
+			name:       "does_not_match_with_message",
+			matcher:    match.Equal(42),
+			value:      43,
+			msgAndArgs: []any{"answer for %s", "everything"},
+			wantFatal:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := &fakeTB{}
+			match.Assert(tb, tt.value, tt.matcher, tt.msgAndArgs...)
+			if gotFatal := len(tb.fatals) > 0; gotFatal != tt.wantFatal {
+				t.Errorf("got fatal %v, want %v", gotFatal, tt.wantFatal)
+			}
+			if tb.helperCalls == 0 {
+				t.Error("expected Helper() to be called")
+			}
+			if len(tb.errors) != 0 {
+				t.Errorf("expected no non-fatal failures, got %q", tb.errors)
+			}
+			goldie.Assert(t, tt.name, []byte(strings.Join(tb.fatals, "\n")))
+		})
+	}
+}
@@ -65,3 +65,13 @@ func GreaterThanOrEqual[T cmp.Ordered](other T) Matcher[T] {
 	}
 	return greaterThanOrEqualImpl(tm, "match.GreaterThanOrEqual", other)
 }
+
+func Between[T cmp.Ordered](lo, hi T, opts ...BetweenOption) Matcher[T] {
+	tm := struct {
+		typemap.StringFunc[T]
+		typemap.DefaultOrder[T]
+	}{
+		StringFunc: DefaultString[T],
+	}
+	return betweenImpl(tm, "match.Between", lo, hi, opts)
+}
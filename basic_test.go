@@ -221,3 +221,59 @@ func TestGreaterThanOrEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestBetween(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[int]
+		value   int
+		want    bool
+	}{
+		{
+			name:    "inside",
+			matcher: match.Between(1, 10),
+			value:   5,
+			want:    true,
+		},
+		{
+			name:    "inclusive_bounds",
+			matcher: match.Between(1, 10),
+			value:   10,
+			want:    true,
+		},
+		{
+			name:    "below",
+			matcher: match.Between(1, 10),
+			value:   0,
+			want:    false,
+		},
+		{
+			name:    "above",
+			matcher: match.Between(1, 10),
+			value:   11,
+			want:    false,
+		},
+		{
+			name:    "exclusive_hi",
+			matcher: match.Between(1, 10, match.BetweenExcludeHi()),
+			value:   10,
+			want:    false,
+		},
+		{
+			name:    "exclusive_lo",
+			matcher: match.Between(1, 10, match.BetweenExcludeLo()),
+			value:   1,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/krelinga/go-match/matchfmt"
+	"github.com/krelinga/go-typemap"
+)
+
+type betweenOptions struct {
+	excludeLo bool
+	excludeHi bool
+}
+
+type BetweenOption func(*betweenOptions)
+
+// BetweenExcludeLo makes the lower bound exclusive, so got must be
+// strictly greater than lo.
+func BetweenExcludeLo() BetweenOption {
+	return func(o *betweenOptions) {
+		o.excludeLo = true
+	}
+}
+
+// BetweenExcludeHi makes the upper bound exclusive, so got must be strictly
+// less than hi.
+func BetweenExcludeHi() BetweenOption {
+	return func(o *betweenOptions) {
+		o.excludeHi = true
+	}
+}
+
+func betweenImpl[T any](tm interface {
+	typemap.String[T]
+	typemap.Order[T]
+}, name string, lo, hi T, opts []BetweenOption) Matcher[T] {
+	var options betweenOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	loOK := func(got T) bool {
+		if options.excludeLo {
+			return tm.Order(got, lo) > 0
+		}
+		return tm.Order(got, lo) >= 0
+	}
+	hiOK := func(got T) bool {
+		if options.excludeHi {
+			return tm.Order(got, hi) < 0
+		}
+		return tm.Order(got, hi) <= 0
+	}
+	loBracket, loOp := "[", ">="
+	if options.excludeLo {
+		loBracket, loOp = "(", ">"
+	}
+	hiBracket, hiOp := "]", "<="
+	if options.excludeHi {
+		hiBracket, hiOp = ")", "<"
+	}
+	interval := fmt.Sprintf("got ∈ %s%s, %s%s", loBracket, tm.String(lo), tm.String(hi), hiBracket)
+	return nodeMatcher[T]{
+		matches: func(got T) bool {
+			return loOK(got) && hiOK(got)
+		},
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Name:     name,
+				Expected: interval,
+			}
+			switch {
+			case !loOK(got):
+				node.Actual = fmt.Sprintf("got == %s (violates lower bound: got %s %s)", tm.String(got), loOp, tm.String(lo))
+			case !hiOK(got):
+				node.Actual = fmt.Sprintf("got == %s (violates upper bound: got %s %s)", tm.String(got), hiOp, tm.String(hi))
+			default:
+				node.Matched = true
+			}
+			return node
+		},
+	}
+}
+
+func BetweenTm[T any](tm interface {
+	typemap.String[T]
+	typemap.Order[T]
+}, lo, hi T, opts ...BetweenOption) Matcher[T] {
+	return betweenImpl(tm, "match.BetweenTm", lo, hi, opts)
+}
@@ -0,0 +1,59 @@
+package match_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-typemap"
+)
+
+type caseInsensitiveOrder struct{}
+
+func (caseInsensitiveOrder) Order(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+func TestBetweenTm(t *testing.T) {
+	goldie := newGoldie(t)
+	tm := struct {
+		typemap.StringFunc[string]
+		caseInsensitiveOrder
+	}{
+		StringFunc: match.DefaultString[string],
+	}
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "inside",
+			matcher: match.BetweenTm(tm, "apple", "Melon", match.BetweenExcludeLo(), match.BetweenExcludeHi()),
+			value:   "Banana",
+			want:    true,
+		},
+		{
+			name:    "on_exclusive_bound",
+			matcher: match.BetweenTm(tm, "apple", "Melon", match.BetweenExcludeLo(), match.BetweenExcludeHi()),
+			value:   "APPLE",
+			want:    false,
+		},
+		{
+			name:    "above",
+			matcher: match.BetweenTm(tm, "apple", "Melon"),
+			value:   "pear",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
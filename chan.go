@@ -0,0 +1,133 @@
+package match
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+func chanReceivesImpl[C ~chan E | ~<-chan E, E any](name string, timeout time.Duration, matcher Matcher[E]) Matcher[C] {
+	return onceMatcher(func(got C) matchfmt.Node {
+		node := matchfmt.Node{Name: name}
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case value, ok := <-got:
+			if !ok {
+				node.Expected = fmt.Sprintf("value received within %s", timeout)
+				node.Actual = "channel closed"
+				return node
+			}
+			inner := Describe(value, matcher)
+			node.Matched = inner.Matched
+			node.Details = []matchfmt.Detail{{
+				Label: fmt.Sprintf("received %s:", DefaultString(value)),
+				Node:  &inner,
+			}}
+		case <-timer.C:
+			node.Expected = fmt.Sprintf("value received within %s", timeout)
+			node.Actual = fmt.Sprintf("no value received after %s", timeout)
+		}
+		return node
+	})
+}
+
+func ChanLikeReceives[C ~chan E | ~<-chan E, E any](timeout time.Duration, matcher Matcher[E]) Matcher[C] {
+	return chanReceivesImpl[C]("match.ChanLikeReceives", timeout, matcher)
+}
+
+func ChanReceives[E any](timeout time.Duration, matcher Matcher[E]) Matcher[<-chan E] {
+	return chanReceivesImpl[<-chan E]("match.ChanReceives", timeout, matcher)
+}
+
+func chanClosedImpl[C ~chan E | ~<-chan E, E any](name string, timeout time.Duration) Matcher[C] {
+	return onceMatcher(func(got C) matchfmt.Node {
+		node := matchfmt.Node{
+			Name:     name,
+			Expected: fmt.Sprintf("channel closed within %s", timeout),
+		}
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case value, ok := <-got:
+			if ok {
+				node.Actual = fmt.Sprintf("received %s", DefaultString(value))
+			} else {
+				node.Matched = true
+			}
+		case <-timer.C:
+			node.Actual = fmt.Sprintf("channel still open after %s", timeout)
+		}
+		return node
+	})
+}
+
+func ChanLikeClosed[C ~chan E | ~<-chan E, E any](timeout time.Duration) Matcher[C] {
+	return chanClosedImpl[C]("match.ChanLikeClosed", timeout)
+}
+
+func ChanClosed[E any](timeout time.Duration) Matcher[<-chan E] {
+	return chanClosedImpl[<-chan E]("match.ChanClosed", timeout)
+}
+
+func chanEmptyImpl[C ~chan E | ~<-chan E, E any](name string) Matcher[C] {
+	return nodeMatcher[C]{
+		matches: func(got C) bool {
+			return len(got) == 0
+		},
+		describe: func(got C) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  len(got) == 0,
+				Name:     name,
+				Expected: "len(got) == 0",
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("len(got) == %d", len(got))
+			}
+			return node
+		},
+	}
+}
+
+func ChanLikeEmpty[C ~chan E | ~<-chan E, E any]() Matcher[C] {
+	return chanEmptyImpl[C]("match.ChanLikeEmpty")
+}
+
+func ChanEmpty[E any]() Matcher[<-chan E] {
+	return chanEmptyImpl[<-chan E]("match.ChanEmpty")
+}
+
+func chanDrainsToImpl[C ~chan E | ~<-chan E, E any](name string, timeout time.Duration, matcher Matcher[[]E]) Matcher[C] {
+	return onceMatcher(func(got C) matchfmt.Node {
+		node := matchfmt.Node{Name: name}
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		var values []E
+		for {
+			select {
+			case value, ok := <-got:
+				if ok {
+					values = append(values, value)
+					continue
+				}
+				inner := Describe(values, matcher)
+				node.Matched = inner.Matched
+				node.Details = []matchfmt.Detail{{Node: &inner}}
+				return node
+			case <-timer.C:
+				node.Expected = fmt.Sprintf("channel closed within %s", timeout)
+				node.Actual = fmt.Sprintf("channel still open after %s, received %s", timeout, DefaultString(values))
+				return node
+			}
+		}
+	})
+}
+
+func ChanLikeDrainsTo[C ~chan E | ~<-chan E, E any](timeout time.Duration, matcher Matcher[[]E]) Matcher[C] {
+	return chanDrainsToImpl[C]("match.ChanLikeDrainsTo", timeout, matcher)
+}
+
+func ChanDrainsTo[E any](timeout time.Duration, matcher Matcher[[]E]) Matcher[<-chan E] {
+	return chanDrainsToImpl[<-chan E]("match.ChanDrainsTo", timeout, matcher)
+}
@@ -0,0 +1,221 @@
+package match_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-match"
+)
+
+const chanTimeout = 10 * time.Millisecond
+
+type intChan chan int
+
+func chanOf(values []int, closed bool) chan int {
+	c := make(chan int, len(values))
+	for _, v := range values {
+		c <- v
+	}
+	if closed {
+		close(c)
+	}
+	return c
+}
+
+func TestChanReceives(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[<-chan int]
+		value   <-chan int
+		want    bool
+	}{
+		{
+			name:    "receives_matching_value",
+			matcher: match.ChanReceives(chanTimeout, match.Equal(1)),
+			value:   chanOf([]int{1}, false),
+			want:    true,
+		},
+		{
+			name:    "receives_non_matching_value",
+			matcher: match.ChanReceives(chanTimeout, match.Equal(2)),
+			value:   chanOf([]int{1}, false),
+			want:    false,
+		},
+		{
+			name:    "closed",
+			matcher: match.ChanReceives(chanTimeout, match.Equal(1)),
+			value:   chanOf(nil, true),
+			want:    false,
+		},
+		{
+			name:    "timeout",
+			matcher: match.ChanReceives(chanTimeout, match.Equal(1)),
+			value:   chanOf(nil, false),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestChanLikeReceives(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[intChan]
+		value   intChan
+		want    bool
+	}{
+		{
+			name:    "receives_matching_value",
+			matcher: match.ChanLikeReceives[intChan](chanTimeout, match.Equal(1)),
+			value:   chanOf([]int{1}, false),
+			want:    true,
+		},
+		{
+			name:    "timeout",
+			matcher: match.ChanLikeReceives[intChan](chanTimeout, match.Equal(1)),
+			value:   chanOf(nil, false),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestChanClosed(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[<-chan int]
+		value   <-chan int
+		want    bool
+	}{
+		{
+			name:    "closed",
+			matcher: match.ChanClosed[int](chanTimeout),
+			value:   chanOf(nil, true),
+			want:    true,
+		},
+		{
+			name:    "value_pending",
+			matcher: match.ChanClosed[int](chanTimeout),
+			value:   chanOf([]int{3}, true),
+			want:    false,
+		},
+		{
+			name:    "timeout",
+			matcher: match.ChanClosed[int](chanTimeout),
+			value:   chanOf(nil, false),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestChanEmpty(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[<-chan int]
+		value   <-chan int
+		want    bool
+	}{
+		{
+			name:    "empty",
+			matcher: match.ChanEmpty[int](),
+			value:   chanOf(nil, false),
+			want:    true,
+		},
+		{
+			name:    "buffered_values",
+			matcher: match.ChanEmpty[int](),
+			value:   chanOf([]int{1, 2}, false),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestChanDrainsTo(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[<-chan int]
+		value   <-chan int
+		want    bool
+	}{
+		{
+			name:    "drains_to_matching_values",
+			matcher: match.ChanDrainsTo(chanTimeout, match.SliceElementsAre(match.Equal(1), match.Equal(2))),
+			value:   chanOf([]int{1, 2}, true),
+			want:    true,
+		},
+		{
+			name:    "drains_to_non_matching_values",
+			matcher: match.ChanDrainsTo(chanTimeout, match.SliceElementsAre(match.Equal(1), match.Equal(3))),
+			value:   chanOf([]int{1, 2}, true),
+			want:    false,
+		},
+		{
+			name:    "never_closed",
+			matcher: match.ChanDrainsTo(chanTimeout, match.SliceLength[int](match.Equal(1))),
+			value:   chanOf([]int{1}, false),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestChanDrainsToPipeline(t *testing.T) {
+	c := make(chan int)
+	go func() {
+		defer close(c)
+		for i := range 3 {
+			c <- i
+		}
+	}()
+	matcher := match.ChanLikeDrainsTo[chan int](time.Second, match.SliceElementsAre(match.Equal(0), match.Equal(1), match.Equal(2)))
+	if matched, explanation := matcher.Match(c); !matched {
+		t.Errorf("expected match, got:\n%s", explanation)
+	}
+}
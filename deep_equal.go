@@ -0,0 +1,407 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+type deepEqualOptions struct {
+	ignoreFields   map[string]bool
+	nilEqualsEmpty bool
+	unexported     bool
+}
+
+type DeepEqualOption func(*deepEqualOptions)
+
+// DeepEqualIgnoreFields skips struct fields at the given paths.  Paths use
+// the same syntax as the DeepEqual explanation, for example ".Meta.UpdatedAt".
+// Use "[*]" in place of a slice index or map key to ignore the field in every
+// element, for example ".Items[*].ID".
+func DeepEqualIgnoreFields(paths ...string) DeepEqualOption {
+	return func(o *deepEqualOptions) {
+		for _, path := range paths {
+			o.ignoreFields[path] = true
+		}
+	}
+}
+
+// DeepEqualNilEqualsEmpty treats nil slices and maps as equal to empty ones.
+func DeepEqualNilEqualsEmpty() DeepEqualOption {
+	return func(o *deepEqualOptions) {
+		o.nilEqualsEmpty = true
+	}
+}
+
+// DeepEqualUnexported compares unexported struct fields, which are skipped by
+// default.  Even when they are skipped, a struct with no exported fields does
+// not match if its unexported fields differ, since nothing else would be
+// compared.
+func DeepEqualUnexported() DeepEqualOption {
+	return func(o *deepEqualOptions) {
+		o.unexported = true
+	}
+}
+
+type deepEqualVisit struct {
+	got, want uintptr
+	typ       reflect.Type
+}
+
+type deepEqualState struct {
+	opts    *deepEqualOptions
+	visited map[deepEqualVisit]bool
+	diffs   []string
+}
+
+// formatDeepValue formats v like %#v, except that pointers are shown as "&"
+// followed by what they point to, since addresses change from run to run.
+func formatDeepValue(v reflect.Value) string {
+	var b strings.Builder
+	writeDeepValue(&b, v, map[uintptr]bool{})
+	return b.String()
+}
+
+func writeDeepValue(b *strings.Builder, v reflect.Value, pointers map[uintptr]bool) {
+	if !v.IsValid() {
+		b.WriteString("nil")
+		return
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+	default:
+		if v.CanInterface() {
+			if gs, ok := v.Interface().(fmt.GoStringer); ok {
+				b.WriteString(gs.GoString())
+				return
+			}
+		}
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			fmt.Fprintf(b, "%#v", v)
+			return
+		}
+		if pointers[v.Pointer()] {
+			b.WriteString("&<cycle>")
+			return
+		}
+		pointers[v.Pointer()] = true
+		defer delete(pointers, v.Pointer())
+		b.WriteString("&")
+		writeDeepValue(b, v.Elem(), pointers)
+	case reflect.Interface:
+		if v.IsNil() {
+			fmt.Fprintf(b, "%#v", v)
+			return
+		}
+		writeDeepValue(b, v.Elem(), pointers)
+	case reflect.Struct:
+		b.WriteString(v.Type().String() + "{")
+		for i := range v.NumField() {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(v.Type().Field(i).Name + ":")
+			writeDeepValue(b, v.Field(i), pointers)
+		}
+		b.WriteString("}")
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			fmt.Fprintf(b, "%#v", v)
+			return
+		}
+		b.WriteString(v.Type().String() + "{")
+		for i := range v.Len() {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeDeepValue(b, v.Index(i), pointers)
+		}
+		b.WriteString("}")
+	case reflect.Map:
+		if v.IsNil() {
+			fmt.Fprintf(b, "%#v", v)
+			return
+		}
+		entries := make([]string, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			entries = append(entries, formatDeepValue(iter.Key())+":"+formatDeepValue(iter.Value()))
+		}
+		sort.Strings(entries)
+		b.WriteString(v.Type().String() + "{" + strings.Join(entries, ", ") + "}")
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if v.IsNil() {
+			fmt.Fprintf(b, "%#v", v)
+			return
+		}
+		b.WriteString("(" + v.Type().String() + ")(<non-nil>)")
+	default:
+		fmt.Fprintf(b, "%#v", v)
+	}
+}
+
+func (s *deepEqualState) report(path string, got, want string) {
+	if path == "" {
+		path = "."
+	}
+	s.diffs = append(s.diffs, fmt.Sprintf("%s: %s != %s", path, got, want))
+}
+
+// seen records that got and want are being compared, and reports whether
+// they were already being compared further up the stack.  This keeps cyclic
+// data structures from recursing forever.
+func (s *deepEqualState) seen(got, want reflect.Value) bool {
+	key := deepEqualVisit{got: got.Pointer(), want: want.Pointer(), typ: got.Type()}
+	if s.visited[key] {
+		return true
+	}
+	s.visited[key] = true
+	return false
+}
+
+// equalMethod calls got.Equal(want) if got's type has an Equal method that
+// takes its own type, as time.Time does, and reports whether it did.  Such
+// types usually have fields that do not determine equality.
+func equalMethod(got, want reflect.Value) (equal, ok bool) {
+	if got.Kind() == reflect.Pointer || got.Kind() == reflect.Interface ||
+		!got.CanInterface() || !want.CanInterface() {
+		return false, false
+	}
+	method := got.MethodByName("Equal")
+	if !method.IsValid() {
+		return false, false
+	}
+	t := method.Type()
+	if t.NumIn() != 1 || t.In(0) != got.Type() || t.NumOut() != 1 || t.Out(0).Kind() != reflect.Bool {
+		return false, false
+	}
+	return method.Call([]reflect.Value{want})[0].Bool(), true
+}
+
+// opaqueDiffers reports a difference if got and want are structs with no
+// exported fields whose unexported fields differ, so that skipping
+// unexported fields does not make all such structs equal.
+func (s *deepEqualState) opaqueDiffers(path, genericPath string, got, want reflect.Value) bool {
+	for i := range got.NumField() {
+		if got.Type().Field(i).IsExported() {
+			return false
+		}
+	}
+	opts := *s.opts
+	opts.unexported = true
+	inner := &deepEqualState{opts: &opts, visited: map[deepEqualVisit]bool{}}
+	inner.compare(path, genericPath, got, want)
+	if len(inner.diffs) == 0 {
+		return false
+	}
+	s.report(path, formatDeepValue(got), formatDeepValue(want)+" (unexported fields differ)")
+	return true
+}
+
+func (s *deepEqualState) nilMismatch(path string, got, want reflect.Value) bool {
+	if got.IsNil() == want.IsNil() {
+		return false
+	}
+	if s.opts.nilEqualsEmpty && got.Len() == 0 && want.Len() == 0 {
+		return false
+	}
+	s.report(path, formatDeepValue(got), formatDeepValue(want))
+	return true
+}
+
+func (s *deepEqualState) compare(path, genericPath string, got, want reflect.Value) {
+	if !got.IsValid() || !want.IsValid() {
+		if got.IsValid() != want.IsValid() {
+			s.report(path, formatDeepValue(got), formatDeepValue(want))
+		}
+		return
+	}
+	if got.Type() != want.Type() {
+		s.report(path, "type "+got.Type().String(), "type "+want.Type().String())
+		return
+	}
+	if equal, ok := equalMethod(got, want); ok {
+		if !equal {
+			s.report(path, formatDeepValue(got), formatDeepValue(want))
+		}
+		return
+	}
+	switch got.Kind() {
+	case reflect.Pointer:
+		if got.IsNil() || want.IsNil() {
+			if got.IsNil() != want.IsNil() {
+				s.report(path, formatDeepValue(got), formatDeepValue(want))
+			}
+			return
+		}
+		if got.Pointer() == want.Pointer() || s.seen(got, want) {
+			return
+		}
+		s.compare(path, genericPath, got.Elem(), want.Elem())
+	case reflect.Interface:
+		if got.IsNil() || want.IsNil() {
+			if got.IsNil() != want.IsNil() {
+				s.report(path, formatDeepValue(got), formatDeepValue(want))
+			}
+			return
+		}
+		s.compare(path, genericPath, got.Elem(), want.Elem())
+	case reflect.Struct:
+		if !s.opts.unexported && s.opaqueDiffers(path, genericPath, got, want) {
+			return
+		}
+		for i := 0; i < got.NumField(); i++ {
+			field := got.Type().Field(i)
+			if !field.IsExported() && !s.opts.unexported {
+				continue
+			}
+			fieldPath := path + "." + field.Name
+			fieldGenericPath := genericPath + "." + field.Name
+			if s.opts.ignoreFields[fieldPath] || s.opts.ignoreFields[fieldGenericPath] {
+				continue
+			}
+			s.compare(fieldPath, fieldGenericPath, got.Field(i), want.Field(i))
+		}
+	case reflect.Slice:
+		if s.nilMismatch(path, got, want) {
+			return
+		}
+		if got.Pointer() == want.Pointer() && got.Len() == want.Len() {
+			return
+		}
+		if !got.IsNil() && !want.IsNil() && s.seen(got, want) {
+			return
+		}
+		s.compareSequence(path, genericPath, got, want)
+	case reflect.Array:
+		s.compareSequence(path, genericPath, got, want)
+	case reflect.Map:
+		if s.nilMismatch(path, got, want) {
+			return
+		}
+		if got.Pointer() == want.Pointer() {
+			return
+		}
+		if !got.IsNil() && !want.IsNil() && s.seen(got, want) {
+			return
+		}
+		s.compareMap(path, genericPath, got, want)
+	case reflect.Func:
+		if !got.IsNil() || !want.IsNil() {
+			s.report(path, formatDeepValue(got), formatDeepValue(want)+" (non-nil funcs are never equal)")
+		}
+	default:
+		if !basicEqual(got, want) {
+			s.report(path, formatDeepValue(got), formatDeepValue(want))
+		}
+	}
+}
+
+func (s *deepEqualState) compareSequence(path, genericPath string, got, want reflect.Value) {
+	n := max(got.Len(), want.Len())
+	for i := 0; i < n; i++ {
+		elemPath := fmt.Sprintf("%s[%d]", path, i)
+		elemGenericPath := genericPath + "[*]"
+		switch {
+		case i >= got.Len():
+			s.report(elemPath, "<missing>", formatDeepValue(want.Index(i)))
+		case i >= want.Len():
+			s.report(elemPath, formatDeepValue(got.Index(i)), "<missing>")
+		default:
+			s.compare(elemPath, elemGenericPath, got.Index(i), want.Index(i))
+		}
+	}
+}
+
+func (s *deepEqualState) compareMap(path, genericPath string, got, want reflect.Value) {
+	type entry struct {
+		key       reflect.Value
+		formatted string
+	}
+	var keys []entry
+	for _, key := range got.MapKeys() {
+		keys = append(keys, entry{key: key, formatted: formatDeepValue(key)})
+	}
+	for _, key := range want.MapKeys() {
+		if !got.MapIndex(key).IsValid() {
+			keys = append(keys, entry{key: key, formatted: formatDeepValue(key)})
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].formatted < keys[j].formatted
+	})
+	for _, key := range keys {
+		entryPath := fmt.Sprintf("%s[%s]", path, key.formatted)
+		entryGenericPath := genericPath + "[*]"
+		gotValue, wantValue := got.MapIndex(key.key), want.MapIndex(key.key)
+		switch {
+		case !gotValue.IsValid():
+			s.report(entryPath, "<missing>", formatDeepValue(wantValue))
+		case !wantValue.IsValid():
+			s.report(entryPath, formatDeepValue(gotValue), "<missing>")
+		default:
+			s.compare(entryPath, entryGenericPath, gotValue, wantValue)
+		}
+	}
+}
+
+func basicEqual(got, want reflect.Value) bool {
+	switch got.Kind() {
+	case reflect.Bool:
+		return got.Bool() == want.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return got.Int() == want.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return got.Uint() == want.Uint()
+	case reflect.Float32, reflect.Float64:
+		return got.Float() == want.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return got.Complex() == want.Complex()
+	case reflect.String:
+		return got.String() == want.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return got.Pointer() == want.Pointer()
+	default:
+		panic(fmt.Sprintf("match.DeepEqual: unexpected kind %s", got.Kind()))
+	}
+}
+
+// DeepEqual matches values that are deeply equal to want, listing every
+// difference when they are not.  Values whose type has an Equal method taking
+// that type, such as time.Time, are compared with it.
+func DeepEqual[T any](want T, opts ...DeepEqualOption) Matcher[T] {
+	options := &deepEqualOptions{
+		ignoreFields: map[string]bool{},
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return nodeMatcher[T]{
+		describe: func(got T) matchfmt.Node {
+			state := &deepEqualState{
+				opts:    options,
+				visited: map[deepEqualVisit]bool{},
+			}
+			state.compare("", "", reflect.ValueOf(&got).Elem(), reflect.ValueOf(&want).Elem())
+			node := matchfmt.Node{
+				Matched: len(state.diffs) == 0,
+				Name:    "match.DeepEqual",
+			}
+			if node.Matched {
+				node.Expected = "got deeply equals want"
+			} else {
+				node.Details = []matchfmt.Detail{{
+					Label: "differences (got != want):",
+					Text:  strings.Join(state.diffs, "\n"),
+				}}
+			}
+			return node
+		},
+	}
+}
@@ -0,0 +1,232 @@
+package match_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-match"
+)
+
+type deepItem struct {
+	Name  string
+	Price int
+}
+
+type deepOrder struct {
+	ID     int
+	Items  []deepItem
+	Tags   map[string]string
+	Notes  *string
+	secret string
+}
+
+type deepNode struct {
+	Value int
+	Next  *deepNode
+}
+
+type deepToken struct {
+	id int
+}
+
+type deepSource struct {
+	Host *string
+}
+
+type deepEvent struct {
+	Name   string
+	At     time.Time
+	Token  deepToken
+	Source *deepSource
+}
+
+func newDeepCycle(values ...int) *deepNode {
+	head := &deepNode{Value: values[0]}
+	cur := head
+	for _, v := range values[1:] {
+		cur.Next = &deepNode{Value: v}
+		cur = cur.Next
+	}
+	cur.Next = head
+	return head
+}
+
+func TestDeepEqual(t *testing.T) {
+	goldie := newGoldie(t)
+	want := deepOrder{
+		ID:     1,
+		Items:  []deepItem{{Name: "a", Price: 1}, {Name: "b", Price: 2}, {Name: "c", Price: 4}},
+		Tags:   map[string]string{"env": "prod", "team": "core"},
+		Notes:  ptr("fragile"),
+		secret: "x",
+	}
+	tests := []struct {
+		name    string
+		matcher match.Matcher[deepOrder]
+		value   deepOrder
+		want    bool
+	}{
+		{
+			name:    "equal",
+			matcher: match.DeepEqual(want),
+			value: deepOrder{
+				ID:     1,
+				Items:  []deepItem{{Name: "a", Price: 1}, {Name: "b", Price: 2}, {Name: "c", Price: 4}},
+				Tags:   map[string]string{"env": "prod", "team": "core"},
+				Notes:  ptr("fragile"),
+				secret: "y",
+			},
+			want: true,
+		},
+		{
+			name:    "field_differences",
+			matcher: match.DeepEqual(want),
+			value: deepOrder{
+				ID:    1,
+				Items: []deepItem{{Name: "a", Price: 1}, {Name: "b", Price: 2}, {Name: "c", Price: 3}, {Name: "d", Price: 5}},
+				Tags:  map[string]string{"env": "dev", "owner": "me"},
+				Notes: nil,
+			},
+			want: false,
+		},
+		{
+			name:    "ignore_fields",
+			matcher: match.DeepEqual(want, match.DeepEqualIgnoreFields(".Items[*].Price", ".Tags", ".Notes")),
+			value: deepOrder{
+				ID:    1,
+				Items: []deepItem{{Name: "a", Price: 10}, {Name: "b", Price: 20}, {Name: "c", Price: 30}},
+			},
+			want: true,
+		},
+		{
+			name:    "unexported_fields",
+			matcher: match.DeepEqual(want, match.DeepEqualUnexported()),
+			value: deepOrder{
+				ID:     1,
+				Items:  []deepItem{{Name: "a", Price: 1}, {Name: "b", Price: 2}, {Name: "c", Price: 4}},
+				Tags:   map[string]string{"env": "prod", "team": "core"},
+				Notes:  ptr("fragile"),
+				secret: "y",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestDeepEqualNilEqualsEmpty(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string][]int]
+		value   map[string][]int
+		want    bool
+	}{
+		{
+			name:    "nil_differs_from_empty",
+			matcher: match.DeepEqual(map[string][]int{"a": {}}),
+			value:   map[string][]int{"a": nil},
+			want:    false,
+		},
+		{
+			name:    "nil_equals_empty",
+			matcher: match.DeepEqual(map[string][]int{"a": {}}, match.DeepEqualNilEqualsEmpty()),
+			value:   map[string][]int{"a": nil},
+			want:    true,
+		},
+		{
+			name:    "nil_map_equals_empty_map",
+			matcher: match.DeepEqual(map[string][]int{}, match.DeepEqualNilEqualsEmpty()),
+			value:   nil,
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestDeepEqualCycles(t *testing.T) {
+	tests := []struct {
+		name  string
+		got   *deepNode
+		want  *deepNode
+		match bool
+	}{
+		{
+			name:  "equal_cycles",
+			got:   newDeepCycle(1, 2, 3),
+			want:  newDeepCycle(1, 2, 3),
+			match: true,
+		},
+		{
+			name:  "different_cycles",
+			got:   newDeepCycle(1, 2, 3),
+			want:  newDeepCycle(1, 2, 4),
+			match: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, explanation := match.DeepEqual(tt.want).Match(tt.got)
+			if got != tt.match {
+				t.Errorf("got %v, want %v\n%s", got, tt.match, explanation)
+			}
+		})
+	}
+}
+
+func TestDeepEqualOpaqueValues(t *testing.T) {
+	goldie := newGoldie(t)
+	at := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	want := deepEvent{Name: "x", At: at, Token: deepToken{id: 1}}
+	tests := []struct {
+		name  string
+		value deepEvent
+		want  bool
+	}{
+		{
+			name:  "same_instant",
+			value: deepEvent{Name: "x", At: at.In(time.FixedZone("X", 3600)), Token: deepToken{id: 1}},
+			want:  true,
+		},
+		{
+			name:  "different_time",
+			value: deepEvent{Name: "x", At: at.Add(time.Hour), Token: deepToken{id: 1}},
+			want:  false,
+		},
+		{
+			name:  "opaque_struct",
+			value: deepEvent{Name: "x", At: at, Token: deepToken{id: 2}},
+			want:  false,
+		},
+		{
+			name:  "nested_pointers",
+			value: deepEvent{Name: "x", At: at, Token: deepToken{id: 1}, Source: &deepSource{Host: ptr("a")}},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := match.DeepEqual(want).Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
@@ -11,19 +11,23 @@ func equalImpl[T any](tm interface {
 	typemap.String[T]
 	typemap.Compare[T]
 }, name string, want T) Matcher[T] {
-	return MatcherFunc[T](func(got T) (matched bool, explanation string) {
-		matched = tm.Compare(got, want)
-		expected := fmt.Sprintf("got == %s", tm.String(want))
-		var info string
-		if !matched {
-			actual := fmt.Sprintf("got == %s", tm.String(got))
-			info = matchfmt.ActualVsExpected(actual, expected)
-		} else {
-			info = expected
-		}
-		explanation = matchfmt.Explain(matched, name, info)
-		return
-	})
+	matches := func(got T) bool {
+		return tm.Compare(got, want)
+	}
+	return nodeMatcher[T]{
+		matches: matches,
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  matches(got),
+				Name:     name,
+				Expected: fmt.Sprintf("got == %s", tm.String(want)),
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("got == %s", tm.String(got))
+			}
+			return node
+		},
+	}
 }
 
 func EqualTm[T any](tm interface {
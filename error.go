@@ -0,0 +1,124 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+func describeError(err error) string {
+	if err == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%T(%q)", err, err.Error())
+}
+
+func errorChain(err error) string {
+	desc := describeError(err)
+	var children []error
+	switch x := err.(type) {
+	case interface{ Unwrap() error }:
+		if inner := x.Unwrap(); inner != nil {
+			children = append(children, inner)
+		}
+	case interface{ Unwrap() []error }:
+		children = x.Unwrap()
+	}
+	lines := []string{desc}
+	for _, child := range children {
+		lines = append(lines, matchfmt.Indent(errorChain(child)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func errorChainDetail(err error) matchfmt.Detail {
+	return matchfmt.Detail{Label: "error chain:", Text: errorChain(err)}
+}
+
+func ErrorIs(target error) Matcher[error] {
+	return nodeMatcher[error]{
+		matches: func(got error) bool {
+			return errors.Is(got, target)
+		},
+		describe: func(got error) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  errors.Is(got, target),
+				Name:     "match.ErrorIs",
+				Expected: fmt.Sprintf("errors.Is(got, %s)", describeError(target)),
+				Details:  []matchfmt.Detail{errorChainDetail(got)},
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("no error in chain is %s", describeError(target))
+			}
+			return node
+		},
+	}
+}
+
+func ErrorAs[E error](matcher Matcher[E]) Matcher[error] {
+	return nodeMatcher[error]{
+		impure: !sideEffectFree(matcher),
+		describe: func(got error) matchfmt.Node {
+			typeName := reflect.TypeFor[E]().String()
+			node := matchfmt.Node{Name: "match.ErrorAs"}
+			var target E
+			if errors.As(got, &target) {
+				inner := Describe(target, matcher)
+				node.Matched = inner.Matched
+				node.Details = append(node.Details, matchfmt.Detail{
+					Label: fmt.Sprintf("found %s in chain:", typeName),
+					Node:  &inner,
+				})
+			} else {
+				node.Expected = fmt.Sprintf("chain contains %s", typeName)
+				node.Actual = fmt.Sprintf("no error in chain is %s", typeName)
+			}
+			node.Details = append(node.Details, errorChainDetail(got))
+			return node
+		},
+	}
+}
+
+func ErrorMessage(matcher Matcher[string]) Matcher[error] {
+	return nodeMatcher[error]{
+		impure: !sideEffectFree(matcher),
+		describe: func(got error) matchfmt.Node {
+			if got == nil {
+				return matchfmt.Node{
+					Name:     "match.ErrorMessage",
+					Expected: "got != nil",
+					Actual:   "got == nil",
+				}
+			}
+			inner := Describe(got.Error(), matcher)
+			return matchfmt.Node{
+				Matched: inner.Matched,
+				Name:    "match.ErrorMessage",
+				Details: []matchfmt.Detail{{Node: &inner}, errorChainDetail(got)},
+			}
+		},
+	}
+}
+
+func NoError() Matcher[error] {
+	return nodeMatcher[error]{
+		matches: func(got error) bool {
+			return got == nil
+		},
+		describe: func(got error) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  got == nil,
+				Name:     "match.NoError",
+				Expected: "got == nil",
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("got == %s", describeError(got))
+				node.Details = []matchfmt.Detail{errorChainDetail(got)}
+			}
+			return node
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package match_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/krelinga/go-match"
+)
+
+type codeError struct {
+	code int
+}
+
+func (e *codeError) Error() string {
+	return fmt.Sprintf("code %d", e.code)
+}
+
+var (
+	errNotFound = errors.New("not found")
+	errTimeout  = errors.New("timeout")
+)
+
+func TestErrorIs(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[error]
+		value   error
+		want    bool
+	}{
+		{
+			name:    "direct_match",
+			matcher: match.ErrorIs(errNotFound),
+			value:   errNotFound,
+			want:    true,
+		},
+		{
+			name:    "wrapped_match",
+			matcher: match.ErrorIs(errNotFound),
+			value:   fmt.Errorf("loading user: %w", errNotFound),
+			want:    true,
+		},
+		{
+			name:    "joined_match",
+			matcher: match.ErrorIs(errTimeout),
+			value:   fmt.Errorf("request: %w", errors.Join(errNotFound, errTimeout)),
+			want:    true,
+		},
+		{
+			name:    "no_match",
+			matcher: match.ErrorIs(errTimeout),
+			value:   fmt.Errorf("loading user: %w", errNotFound),
+			want:    false,
+		},
+		{
+			name:    "nil_error",
+			matcher: match.ErrorIs(errTimeout),
+			value:   nil,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestErrorAs(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[error]
+		value   error
+		want    bool
+	}{
+		{
+			name:    "found_and_matches",
+			matcher: match.ErrorAs(match.MatcherFunc[*codeError](func(e *codeError) (bool, string) { return match.Equal(404).Match(e.code) })),
+			value:   fmt.Errorf("request: %w", &codeError{code: 404}),
+			want:    true,
+		},
+		{
+			name:    "found_and_does_not_match",
+			matcher: match.ErrorAs(match.MatcherFunc[*codeError](func(e *codeError) (bool, string) { return match.Equal(500).Match(e.code) })),
+			value:   errors.Join(errNotFound, &codeError{code: 404}),
+			want:    false,
+		},
+		{
+			name:    "not_found",
+			matcher: match.ErrorAs(match.Alway[*codeError]()),
+			value:   fmt.Errorf("request: %w", errTimeout),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[error]
+		value   error
+		want    bool
+	}{
+		{
+			name:    "message_matches",
+			matcher: match.ErrorMessage(match.StringContains("not found")),
+			value:   fmt.Errorf("loading user: %w", errNotFound),
+			want:    true,
+		},
+		{
+			name:    "message_does_not_match",
+			matcher: match.ErrorMessage(match.Equal("timeout")),
+			value:   errNotFound,
+			want:    false,
+		},
+		{
+			name:    "nil_error",
+			matcher: match.ErrorMessage(match.Equal("timeout")),
+			value:   nil,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestNoError(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[error]
+		value   error
+		want    bool
+	}{
+		{
+			name:    "nil_error",
+			matcher: match.NoError(),
+			value:   nil,
+			want:    true,
+		},
+		{
+			name:    "non_nil_error",
+			matcher: match.NoError(),
+			value:   fmt.Errorf("loading user: %w", errNotFound),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package match
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+// minPollInterval is the interval used in place of a non-positive one, so
+// that polling continues until the deadline rather than stopping at once.
+const minPollInterval = time.Millisecond
+
+// pollWait waits for the next attempt, which is never later than the
+// deadline, so that a final attempt is made at the deadline itself.  It
+// returns false if the deadline has already been reached or ctx is done.
+func pollWait(ctx context.Context, deadline time.Time, interval time.Duration) bool {
+	wait := min(max(interval, minPollInterval), time.Until(deadline))
+	if wait <= 0 {
+		return false
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func attempts(n int) string {
+	if n == 1 {
+		return "1 attempt"
+	}
+	return fmt.Sprintf("%d attempts", n)
+}
+
+func Eventually[T any](poll func() T, matcher Matcher[T], timeout, interval time.Duration) Matcher[context.Context] {
+	return onceMatcher(func(ctx context.Context) matchfmt.Node {
+		node := matchfmt.Node{
+			Name:     "match.Eventually",
+			Expected: fmt.Sprintf("match within %s", timeout),
+		}
+		deadline := time.Now().Add(timeout)
+		for attempt := 1; ; attempt++ {
+			inner := Describe(poll(), matcher)
+			if inner.Matched {
+				node.Matched = true
+				node.Details = []matchfmt.Detail{{
+					Label: fmt.Sprintf("matched on attempt %d:", attempt),
+					Node:  &inner,
+				}}
+				return node
+			}
+			if !pollWait(ctx, deadline, interval) {
+				if err := ctx.Err(); err != nil {
+					node.Actual = fmt.Sprintf("%v after %s", err, attempts(attempt))
+				} else {
+					node.Actual = fmt.Sprintf("no match after %s", attempts(attempt))
+				}
+				node.Details = []matchfmt.Detail{{
+					Label: "last attempt:",
+					Node:  &inner,
+				}}
+				return node
+			}
+		}
+	})
+}
+
+func Consistently[T any](poll func() T, matcher Matcher[T], duration, interval time.Duration) Matcher[context.Context] {
+	return onceMatcher(func(ctx context.Context) matchfmt.Node {
+		node := matchfmt.Node{
+			Name:     "match.Consistently",
+			Expected: fmt.Sprintf("match on every attempt for %s", duration),
+		}
+		deadline := time.Now().Add(duration)
+		for attempt := 1; ; attempt++ {
+			inner := Describe(poll(), matcher)
+			if !inner.Matched {
+				node.Actual = fmt.Sprintf("attempt %d did not match", attempt)
+				node.Details = []matchfmt.Detail{{
+					Label: fmt.Sprintf("attempt %d:", attempt),
+					Node:  &inner,
+				}}
+				return node
+			}
+			if !pollWait(ctx, deadline, interval) {
+				if err := ctx.Err(); err != nil {
+					node.Actual = fmt.Sprintf("%v after %s", err, attempts(attempt))
+					node.Details = []matchfmt.Detail{{
+						Label: "last attempt:",
+						Node:  &inner,
+					}}
+					return node
+				}
+				node.Matched = true
+				node.Details = []matchfmt.Detail{{
+					Label: fmt.Sprintf("matched %s, last attempt:", attempts(attempt)),
+					Node:  &inner,
+				}}
+				return node
+			}
+		}
+	})
+}
@@ -0,0 +1,158 @@
+package match_test
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-match"
+)
+
+// counter returns a poll func that returns 1, 2, 3, ... on successive calls.
+func counter() func() int {
+	var n atomic.Int64
+	return func() int {
+		return int(n.Add(1))
+	}
+}
+
+func TestEventually(t *testing.T) {
+	goldie := newGoldie(t)
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name    string
+		matcher match.Matcher[context.Context]
+		ctx     context.Context
+		want    bool
+	}{
+		{
+			name:    "matches_first_attempt",
+			matcher: match.Eventually(counter(), match.Equal(1), time.Second, time.Millisecond),
+			ctx:     context.Background(),
+			want:    true,
+		},
+		{
+			name:    "matches_third_attempt",
+			matcher: match.Eventually(counter(), match.Equal(3), time.Second, time.Millisecond),
+			ctx:     context.Background(),
+			want:    true,
+		},
+		{
+			name:    "timeout",
+			matcher: match.Eventually(func() int { return 1 }, match.Equal(2), 0, time.Millisecond),
+			ctx:     context.Background(),
+			want:    false,
+		},
+		{
+			name:    "context_canceled",
+			matcher: match.Eventually(func() int { return 1 }, match.Equal(2), time.Second, time.Millisecond),
+			ctx:     canceled,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.ctx)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestConsistently(t *testing.T) {
+	goldie := newGoldie(t)
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name    string
+		matcher match.Matcher[context.Context]
+		ctx     context.Context
+		want    bool
+	}{
+		{
+			name:    "fails_third_attempt",
+			matcher: match.Consistently(counter(), match.LessThan(3), time.Second, time.Millisecond),
+			ctx:     context.Background(),
+			want:    false,
+		},
+		{
+			name:    "single_attempt",
+			matcher: match.Consistently(counter(), match.Equal(1), 0, time.Millisecond),
+			ctx:     context.Background(),
+			want:    true,
+		},
+		{
+			name:    "context_canceled",
+			matcher: match.Consistently(counter(), match.Equal(1), time.Second, time.Millisecond),
+			ctx:     canceled,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.ctx)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestConsistentlyPollsRepeatedly(t *testing.T) {
+	poll := counter()
+	matcher := match.Consistently(poll, match.GreaterThan(0), 20*time.Millisecond, time.Millisecond)
+	matched, explanation := matcher.Match(context.Background())
+	if !matched {
+		t.Fatalf("expected match, got:\n%s", explanation)
+	}
+	if attempts := poll() - 1; attempts < 2 {
+		t.Errorf("got %d attempts, want at least 2", attempts)
+	}
+	if !strings.Contains(explanation, "attempts, last attempt") {
+		t.Errorf("explanation does not report the attempt count:\n%s", explanation)
+	}
+}
+
+func TestEventuallyPollsAtDeadline(t *testing.T) {
+	const timeout = 20 * time.Millisecond
+	start := time.Now()
+	poll := func() time.Duration {
+		return time.Since(start)
+	}
+	matcher := match.Eventually(poll, match.GreaterThanOrEqual(timeout), timeout, time.Hour)
+	matched, explanation := matcher.Match(context.Background())
+	if !matched {
+		t.Fatalf("expected a match on the attempt at the deadline, got:\n%s", explanation)
+	}
+	if !strings.Contains(explanation, "matched on attempt 2:") {
+		t.Errorf("explanation does not report the second attempt:\n%s", explanation)
+	}
+}
+
+func TestNonPositiveInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		t.Run(interval.String(), func(t *testing.T) {
+			poll := counter()
+			matcher := match.Eventually(poll, match.GreaterThanOrEqual(3), time.Second, interval)
+			if matched, explanation := matcher.Match(context.Background()); !matched {
+				t.Errorf("Eventually: expected match, got:\n%s", explanation)
+			}
+
+			poll = counter()
+			matcher = match.Consistently(poll, match.GreaterThan(0), 20*time.Millisecond, interval)
+			matched, explanation := matcher.Match(context.Background())
+			if !matched {
+				t.Fatalf("Consistently: expected match, got:\n%s", explanation)
+			}
+			if attempts := poll() - 1; attempts < 2 {
+				t.Errorf("Consistently: got %d attempts, want at least 2", attempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package match
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"unsafe"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+type floatLike interface {
+	~float32 | ~float64
+}
+
+type complexLike interface {
+	~complex64 | ~complex128
+}
+
+func floatAbs[T floatLike](v T) T {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// floatSpecial handles NaN and infinite operands, which never have a
+// meaningful finite delta.  It reports whether either operand was special.
+func floatSpecial[T floatLike](name string, got, want T) (special bool, node matchfmt.Node) {
+	g, w := float64(got), float64(want)
+	node.Name = name
+	switch {
+	case math.IsNaN(g) || math.IsNaN(w):
+		node.Expected = fmt.Sprintf("got ≈ %s", DefaultString(want))
+		node.Actual = fmt.Sprintf("got == %s (NaN is never near any value)", DefaultString(got))
+		return true, node
+	case math.IsInf(g, 0) || math.IsInf(w, 0):
+		node.Matched = g == w
+		node.Expected = fmt.Sprintf("got == %s", DefaultString(want))
+		if !node.Matched {
+			node.Actual = fmt.Sprintf("got == %s", DefaultString(got))
+		}
+		return true, node
+	}
+	return false, node
+}
+
+func floatToleranceImpl[T floatLike](name string, want T, allowed T, allowedDesc string) Matcher[T] {
+	return nodeMatcher[T]{
+		describe: func(got T) matchfmt.Node {
+			special, node := floatSpecial(name, got, want)
+			if special {
+				return node
+			}
+			delta := floatAbs(got - want)
+			node.Matched = delta <= allowed
+			node.Expected = fmt.Sprintf("|got - %s| <= %s", DefaultString(want), allowedDesc)
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("|got - %s| == %s (got == %s)", DefaultString(want), DefaultString(delta), DefaultString(got))
+			}
+			return node
+		},
+	}
+}
+
+func FloatNear[T floatLike](want, absTol T) Matcher[T] {
+	return floatToleranceImpl("match.FloatNear", want, absTol, DefaultString(absTol))
+}
+
+func FloatRelNear[T floatLike](want, relTol T) Matcher[T] {
+	allowed := relTol * floatAbs(want)
+	allowedDesc := fmt.Sprintf("%s * |%s| (= %s)", DefaultString(relTol), DefaultString(want), DefaultString(allowed))
+	return floatToleranceImpl("match.FloatRelNear", want, allowed, allowedDesc)
+}
+
+// ulpOrdinal maps a float onto an integer line where adjacent representable
+// values differ by one, so that the ULP distance is a simple subtraction.
+func ulpOrdinal[T floatLike](v T) int64 {
+	if unsafe.Sizeof(v) == 4 {
+		i := int64(int32(math.Float32bits(float32(v))))
+		if i < 0 {
+			i = math.MinInt32 - i
+		}
+		return i
+	}
+	i := int64(math.Float64bits(float64(v)))
+	if i < 0 {
+		i = math.MinInt64 - i
+	}
+	return i
+}
+
+func ulpDistance[T floatLike](a, b T) uint64 {
+	x, y := ulpOrdinal(a), ulpOrdinal(b)
+	if x > y {
+		return uint64(x) - uint64(y)
+	}
+	return uint64(y) - uint64(x)
+}
+
+func FloatWithinULPs[T floatLike](want T, n uint64) Matcher[T] {
+	return nodeMatcher[T]{
+		describe: func(got T) matchfmt.Node {
+			special, node := floatSpecial("match.FloatWithinULPs", got, want)
+			if special {
+				return node
+			}
+			distance := ulpDistance(got, want)
+			node.Matched = distance <= n
+			node.Expected = fmt.Sprintf("got within %d ULPs of %s", n, DefaultString(want))
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("got == %s, %d ULPs away", DefaultString(got), distance)
+			}
+			return node
+		},
+	}
+}
+
+func floatPredicateImpl[T floatLike](name, expected string, pred func(float64) bool) Matcher[T] {
+	return nodeMatcher[T]{
+		matches: func(got T) bool {
+			return pred(float64(got))
+		},
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  pred(float64(got)),
+				Name:     name,
+				Expected: expected,
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("got == %s", DefaultString(got))
+			}
+			return node
+		},
+	}
+}
+
+func FloatIsNaN[T floatLike]() Matcher[T] {
+	return floatPredicateImpl[T]("match.FloatIsNaN", "got is NaN", math.IsNaN)
+}
+
+// FloatIsInf follows the sign convention of math.IsInf: sign > 0 matches
+// +Inf, sign < 0 matches -Inf and sign == 0 matches either.
+func FloatIsInf[T floatLike](sign int) Matcher[T] {
+	var expected string
+	switch {
+	case sign > 0:
+		expected = "got == +Inf"
+	case sign < 0:
+		expected = "got == -Inf"
+	default:
+		expected = "got == ±Inf"
+	}
+	return floatPredicateImpl[T]("match.FloatIsInf", expected, func(f float64) bool {
+		return math.IsInf(f, sign)
+	})
+}
+
+func FloatIsFinite[T floatLike]() Matcher[T] {
+	return floatPredicateImpl[T]("match.FloatIsFinite", "got is finite", func(f float64) bool {
+		return !math.IsNaN(f) && !math.IsInf(f, 0)
+	})
+}
+
+func complexToleranceImpl[T complexLike](name string, want T, allowed float64, allowedDesc string) Matcher[T] {
+	return nodeMatcher[T]{
+		describe: func(got T) matchfmt.Node {
+			g, w := complex128(got), complex128(want)
+			node := matchfmt.Node{
+				Name:     name,
+				Expected: fmt.Sprintf("|got - %s| <= %s", DefaultString(want), allowedDesc),
+			}
+			switch {
+			case cmplx.IsNaN(g) || cmplx.IsNaN(w):
+				node.Actual = fmt.Sprintf("got == %s (NaN is never near any value)", DefaultString(got))
+			case cmplx.IsInf(g) || cmplx.IsInf(w):
+				node.Matched = g == w
+				if !node.Matched {
+					node.Actual = fmt.Sprintf("got == %s", DefaultString(got))
+				}
+			default:
+				delta := cmplx.Abs(g - w)
+				node.Matched = delta <= allowed
+				if !node.Matched {
+					node.Actual = fmt.Sprintf("|got - %s| == %v (got == %s)", DefaultString(want), delta, DefaultString(got))
+				}
+			}
+			return node
+		},
+	}
+}
+
+func ComplexNear[T complexLike](want T, absTol float64) Matcher[T] {
+	return complexToleranceImpl("match.ComplexNear", want, absTol, fmt.Sprint(absTol))
+}
+
+func ComplexRelNear[T complexLike](want T, relTol float64) Matcher[T] {
+	allowed := relTol * cmplx.Abs(complex128(want))
+	allowedDesc := fmt.Sprintf("%v * |%s| (= %v)", relTol, DefaultString(want), allowed)
+	return complexToleranceImpl("match.ComplexRelNear", want, allowed, allowedDesc)
+}
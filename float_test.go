@@ -0,0 +1,382 @@
+package match_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/krelinga/go-match"
+)
+
+func TestFloatNear(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[float64]
+		value   float64
+		want    bool
+	}{
+		{
+			name:    "within_tolerance",
+			matcher: match.FloatNear(1.0, 0.01),
+			value:   1.005,
+			want:    true,
+		},
+		{
+			name:    "outside_tolerance",
+			matcher: match.FloatNear(1.0, 0.01),
+			value:   1.5,
+			want:    false,
+		},
+		{
+			name:    "got_nan",
+			matcher: match.FloatNear(1.0, 0.01),
+			value:   math.NaN(),
+			want:    false,
+		},
+		{
+			name:    "both_positive_inf",
+			matcher: match.FloatNear(math.Inf(1), 0.01),
+			value:   math.Inf(1),
+			want:    true,
+		},
+		{
+			name:    "opposite_inf",
+			matcher: match.FloatNear(math.Inf(1), 0.01),
+			value:   math.Inf(-1),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestFloatNearFloat32(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[float32]
+		value   float32
+		want    bool
+	}{
+		{
+			name:    "within_tolerance",
+			matcher: match.FloatNear[float32](1.0, 0.25),
+			value:   1.125,
+			want:    true,
+		},
+		{
+			name:    "outside_tolerance",
+			matcher: match.FloatNear[float32](1.0, 0.25),
+			value:   1.5,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestFloatRelNear(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[float64]
+		value   float64
+		want    bool
+	}{
+		{
+			name:    "within_tolerance",
+			matcher: match.FloatRelNear(200.0, 0.01),
+			value:   201.0,
+			want:    true,
+		},
+		{
+			name:    "outside_tolerance",
+			matcher: match.FloatRelNear(200.0, 0.01),
+			value:   203.0,
+			want:    false,
+		},
+		{
+			name:    "want_nan",
+			matcher: match.FloatRelNear(math.NaN(), 0.01),
+			value:   math.NaN(),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestFloatWithinULPs(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[float64]
+		value   float64
+		want    bool
+	}{
+		{
+			name:    "exact",
+			matcher: match.FloatWithinULPs(0.3, 0),
+			value:   0.3,
+			want:    true,
+		},
+		{
+			name:    "within_ulps",
+			matcher: match.FloatWithinULPs(0.3, 4),
+			value:   math.Nextafter(math.Nextafter(0.3, 1), 1),
+			want:    true,
+		},
+		{
+			name:    "outside_ulps",
+			matcher: match.FloatWithinULPs(0.3, 4),
+			value:   0.3000001,
+			want:    false,
+		},
+		{
+			name:    "across_zero",
+			matcher: match.FloatWithinULPs(math.SmallestNonzeroFloat64, 2),
+			value:   -math.SmallestNonzeroFloat64,
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestFloatWithinULPsFloat32(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[float32]
+		value   float32
+		want    bool
+	}{
+		{
+			name:    "adjacent",
+			matcher: match.FloatWithinULPs[float32](1.0, 1),
+			value:   math.Nextafter32(1.0, 2.0),
+			want:    true,
+		},
+		{
+			name:    "outside_ulps",
+			matcher: match.FloatWithinULPs[float32](1.0, 1),
+			value:   1.001,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestFloatIsNaN(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[float64]
+		value   float64
+		want    bool
+	}{
+		{
+			name:    "nan",
+			matcher: match.FloatIsNaN[float64](),
+			value:   math.NaN(),
+			want:    true,
+		},
+		{
+			name:    "not_nan",
+			matcher: match.FloatIsNaN[float64](),
+			value:   1.0,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestFloatIsInf(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[float64]
+		value   float64
+		want    bool
+	}{
+		{
+			name:    "positive_inf",
+			matcher: match.FloatIsInf[float64](1),
+			value:   math.Inf(1),
+			want:    true,
+		},
+		{
+			name:    "negative_inf_any_sign",
+			matcher: match.FloatIsInf[float64](0),
+			value:   math.Inf(-1),
+			want:    true,
+		},
+		{
+			name:    "negative_inf_positive_sign",
+			matcher: match.FloatIsInf[float64](1),
+			value:   math.Inf(-1),
+			want:    false,
+		},
+		{
+			name:    "finite",
+			matcher: match.FloatIsInf[float64](-1),
+			value:   1.0,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestFloatIsFinite(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[float64]
+		value   float64
+		want    bool
+	}{
+		{
+			name:    "finite",
+			matcher: match.FloatIsFinite[float64](),
+			value:   1.0,
+			want:    true,
+		},
+		{
+			name:    "nan",
+			matcher: match.FloatIsFinite[float64](),
+			value:   math.NaN(),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestComplexNear(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[complex128]
+		value   complex128
+		want    bool
+	}{
+		{
+			name:    "within_tolerance",
+			matcher: match.ComplexNear(1+1i, 0.01),
+			value:   1.005 + 1i,
+			want:    true,
+		},
+		{
+			name:    "outside_tolerance",
+			matcher: match.ComplexNear(1+1i, 0.01),
+			value:   1 + 2i,
+			want:    false,
+		},
+		{
+			name:    "got_nan",
+			matcher: match.ComplexNear(1+1i, 0.01),
+			value:   complex(math.NaN(), 0),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestComplexRelNear(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[complex64]
+		value   complex64
+		want    bool
+	}{
+		{
+			name:    "within_tolerance",
+			matcher: match.ComplexRelNear[complex64](3+4i, 0.01),
+			value:   3 + 4.04i,
+			want:    true,
+		},
+		{
+			name:    "outside_tolerance",
+			matcher: match.ComplexRelNear[complex64](3+4i, 0.01),
+			value:   3 + 5i,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
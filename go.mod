@@ -5,6 +5,7 @@ go 1.24.3
 require (
 	github.com/krelinga/go-typemap v0.0.9
 	github.com/sebdah/goldie/v2 v2.7.1
+	golang.org/x/text v0.25.0
 )
 
 require (
@@ -11,19 +11,23 @@ func greaterThanImpl[T any](tm interface {
 	typemap.String[T]
 	typemap.Order[T]
 }, name string, other T) Matcher[T] {
-	return MatcherFunc[T](func(got T) (matched bool, explanation string) {
-		matched = tm.Order(got, other) > 0
-		expected := fmt.Sprintf("got > %s", tm.String(other))
-		var info string
-		if !matched {
-			actual := fmt.Sprintf("got == %s", tm.String(got))
-			info = matchfmt.ActualVsExpected(actual, expected)
-		} else {
-			info = expected
-		}
-		explanation = matchfmt.Explain(matched, name, info)
-		return
-	})
+	matches := func(got T) bool {
+		return tm.Order(got, other) > 0
+	}
+	return nodeMatcher[T]{
+		matches: matches,
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  matches(got),
+				Name:     name,
+				Expected: fmt.Sprintf("got > %s", tm.String(other)),
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("got == %s", tm.String(got))
+			}
+			return node
+		},
+	}
 }
 
 func GreaterThanTm[T any](tm interface {
@@ -8,19 +8,23 @@ import (
 )
 
 func hasKeyImpl[T, K any](containerTm typemap.HasKey[T, K], keyTm typemap.String[K], matcherName, keyName string, key K) Matcher[T] {
-	return MatcherFunc[T](func(got T) (matched bool, explanation string) {
-		matched = containerTm.HasKey(got, key)
-		expected := fmt.Sprintf("has %s %s", keyName, keyTm.String(key))
-		var detail string
-		if matched {
-			detail = expected
-		} else {
-			actual := fmt.Sprintf("%s %s not found", keyName, keyTm.String(key))
-			detail = matchfmt.ActualVsExpected(actual, expected)
-		}
-		explanation = matchfmt.Explain(matched, matcherName, detail)
-		return
-	})
+	matches := func(got T) bool {
+		return containerTm.HasKey(got, key)
+	}
+	return nodeMatcher[T]{
+		matches: matches,
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  matches(got),
+				Name:     matcherName,
+				Expected: fmt.Sprintf("has %s %s", keyName, keyTm.String(key)),
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("%s %s not found", keyName, keyTm.String(key))
+			}
+			return node
+		},
+	}
 }
 
 func HasKeyTm[T, K any](containerTm typemap.HasKey[T, K], keyTm typemap.String[K], key K) Matcher[T] {
@@ -11,19 +11,23 @@ func isNilImpl[T any](tm interface {
 	typemap.IsNil[T]
 	typemap.String[T]
 }, name string) Matcher[T] {
-	return MatcherFunc[T](func(got T) (matched bool, explanation string) {
-		matched = tm.IsNil(got)
-		expected := "got == nil"
-		var detail string
-		if matched {
-			detail = expected
-		} else {
-			actual := fmt.Sprintf("got = %s", tm.String(got))
-			detail = matchfmt.ActualVsExpected(actual, expected)
-		}
-		explanation = matchfmt.Explain(matched, name, detail)
-		return
-	})
+	matches := func(got T) bool {
+		return tm.IsNil(got)
+	}
+	return nodeMatcher[T]{
+		matches: matches,
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  matches(got),
+				Name:     name,
+				Expected: "got == nil",
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("got = %s", tm.String(got))
+			}
+			return node
+		},
+	}
 }
 
 func IsNilTm[T any](tm interface {
@@ -1,17 +1,27 @@
 package match
 
 import (
+	"unicode/utf8"
+
 	"github.com/krelinga/go-match/matchfmt"
 	"github.com/krelinga/go-typemap"
 )
 
 func lengthImpl[T any](tm typemap.Length[T], name string, matcher Matcher[int]) Matcher[T] {
-	return MatcherFunc[T](func(got T) (matched bool, explanation string) {
-		length := tm.Length(got)
-		matched, e := matcher.Match(length)
-		explanation = matchfmt.Explain(matched, name, e)
-		return
-	})
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(matcher),
+		matches: func(got T) bool {
+			return Matches(tm.Length(got), matcher)
+		},
+		describe: func(got T) matchfmt.Node {
+			inner := Describe(tm.Length(got), matcher)
+			return matchfmt.Node{
+				Matched: inner.Matched,
+				Name:    name,
+				Details: []matchfmt.Detail{{Node: &inner}},
+			}
+		},
+	}
 }
 
 func LengthTm[T any](tm typemap.Length[T], matcher Matcher[int]) Matcher[T] {
@@ -32,6 +42,20 @@ func StringLength(matcher Matcher[int]) Matcher[string] {
 	return lengthImpl(tm, "match.StringLength", matcher)
 }
 
+type runeLengthTm[T ~string] struct{}
+
+func (runeLengthTm[T]) Length(v T) int {
+	return utf8.RuneCountInString(string(v))
+}
+
+func StringLikeRuneLength[T ~string](matcher Matcher[int]) Matcher[T] {
+	return lengthImpl(runeLengthTm[T]{}, "match.StringLikeRuneLength", matcher)
+}
+
+func StringRuneLength(matcher Matcher[int]) Matcher[string] {
+	return lengthImpl(runeLengthTm[string]{}, "match.StringRuneLength", matcher)
+}
+
 func SliceLikeLength[T ~[]E, E any](matcher Matcher[int]) Matcher[T] {
 	tm := typemap.ForSliceLike[T, E]{
 		StringFunc: DefaultString[T],
@@ -106,6 +106,70 @@ func TestStringLength(t *testing.T) {
 	}
 }
 
+func TestStringLikeRuneLength(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "length_equal",
+			matcher: match.StringLikeRuneLength[string](match.Equal(5)),
+			value:   "héllo",
+			want:    true,
+		},
+		{
+			name:    "length_not_equal",
+			matcher: match.StringLikeRuneLength[string](match.Equal(6)),
+			value:   "héllo",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestStringRuneLength(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "counts_runes_not_bytes",
+			matcher: match.StringRuneLength(match.Equal(2)),
+			value:   "日本",
+			want:    true,
+		},
+		{
+			name:    "length_not_equal",
+			matcher: match.StringRuneLength(match.Equal(6)),
+			value:   "日本",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
 func TestSliceLikeLength(t *testing.T) {
 	goldie := newGoldie(t)
 	tests := []struct {
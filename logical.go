@@ -7,62 +7,112 @@ import (
 )
 
 func AllOf[T any](matchers ...Matcher[T]) Matcher[T] {
-	return MatcherFunc[T](func(got T) (matched bool, explanation string) {
-		matched = true
-		details := make([]string, 0, len(matchers))
-		for i, matcher := range matchers {
-			m, e := matcher.Match(got)
-			if !m {
-				matched = false
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(matchers...),
+		matches: func(got T) bool {
+			for _, matcher := range matchers {
+				if !Matches(got, matcher) {
+					return false
+				}
 			}
-			indexDetail := fmt.Sprintf("matcher %d:", i)
-			matcherDetail := matchfmt.Indent(e)
-			details = append(details, indexDetail, matcherDetail)
-		}
-		explanation = matchfmt.Explain(matched, "match.AllOf", details...)
-		return
-	})
+			return true
+		},
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched: true,
+				Name:    "match.AllOf",
+				Details: make([]matchfmt.Detail, 0, len(matchers)),
+			}
+			for i, matcher := range matchers {
+				inner := Describe(got, matcher)
+				if !inner.Matched {
+					node.Matched = false
+				}
+				node.Details = append(node.Details, matchfmt.Detail{
+					Label: fmt.Sprintf("matcher %d:", i),
+					Node:  &inner,
+				})
+			}
+			return node
+		},
+	}
 }
 
 func AnyOf[T any](matchers ...Matcher[T]) Matcher[T] {
-	return MatcherFunc[T](func(got T) (matched bool, explanation string) {
-		matched = false
-		details := make([]string, 0, len(matchers))
-		for i, matcher := range matchers {
-			m, e := matcher.Match(got)
-			if m {
-				matched = true
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(matchers...),
+		matches: func(got T) bool {
+			for _, matcher := range matchers {
+				if Matches(got, matcher) {
+					return true
+				}
+			}
+			return false
+		},
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched: false,
+				Name:    "match.AnyOf",
+				Details: make([]matchfmt.Detail, 0, len(matchers)),
+			}
+			for i, matcher := range matchers {
+				inner := Describe(got, matcher)
+				if inner.Matched {
+					node.Matched = true
+				}
+				node.Details = append(node.Details, matchfmt.Detail{
+					Label: fmt.Sprintf("matcher %d:", i),
+					Node:  &inner,
+				})
 			}
-			indexDetail := fmt.Sprintf("matcher %d:", i)
-			matcherDetail := matchfmt.Indent(e)
-			details = append(details, indexDetail, matcherDetail)
-		}
-		explanation = matchfmt.Explain(matched, "match.AnyOf", details...)
-		return
-	})
+			return node
+		},
+	}
 }
 
 func Not[T any](matcher Matcher[T]) Matcher[T] {
-	return MatcherFunc[T](func(got T) (matched bool, explanation string) {
-		m, e := matcher.Match(got)
-		matched = !m
-		explanation = matchfmt.Explain(matched, "match.Not", "negated matcher:", matchfmt.Indent(e))
-		return
-	})
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(matcher),
+		matches: func(got T) bool {
+			return !Matches(got, matcher)
+		},
+		describe: func(got T) matchfmt.Node {
+			inner := Describe(got, matcher)
+			return matchfmt.Node{
+				Matched: !inner.Matched,
+				Name:    "match.Not",
+				Details: []matchfmt.Detail{{Label: "negated matcher:", Node: &inner}},
+			}
+		},
+	}
 }
 
 func Alway[T any]() Matcher[T] {
-	return MatcherFunc[T](func(got T) (matched bool, explanation string) {
-		matched = true
-		explanation = matchfmt.Explain(matched, "match.Alway", "always matches")
-		return
-	})
+	return nodeMatcher[T]{
+		matches: func(got T) bool {
+			return true
+		},
+		describe: func(got T) matchfmt.Node {
+			return matchfmt.Node{
+				Matched:  true,
+				Name:     "match.Alway",
+				Expected: "always matches",
+			}
+		},
+	}
 }
 
 func Never[T any]() Matcher[T] {
-	return MatcherFunc[T](func(got T) (matched bool, explanation string) {
-		matched = false
-		explanation = matchfmt.Explain(matched, "match.Never", "never matches")
-		return
-	})
+	return nodeMatcher[T]{
+		matches: func(got T) bool {
+			return false
+		},
+		describe: func(got T) matchfmt.Node {
+			return matchfmt.Node{
+				Matched:  false,
+				Name:     "match.Never",
+				Expected: "never matches",
+			}
+		},
+	}
 }
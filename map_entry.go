@@ -0,0 +1,234 @@
+package match
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/krelinga/go-match/matchfmt"
+	"github.com/krelinga/go-typemap"
+)
+
+func keyList[K any](keyTm typemap.String[K], keys []K) string {
+	strs := make([]string, len(keys))
+	for i, key := range keys {
+		strs[i] = keyTm.String(key)
+	}
+	return "[" + strings.Join(strs, ", ") + "]"
+}
+
+func sortKeysByString[K any](keyTm typemap.String[K], keys []K) {
+	slices.SortFunc(keys, func(a, b K) int {
+		return cmp.Compare(keyTm.String(a), keyTm.String(b))
+	})
+}
+
+func mapEntryDetail[T, K, V any](containerTm typemap.GetValue[T, K, V], keyTm typemap.String[K], got T, key K, matcher Matcher[V]) (matched bool, detail matchfmt.Detail) {
+	detail.Label = fmt.Sprintf("key %s:", keyTm.String(key))
+	value, found := containerTm.GetValue(got, key)
+	if !found {
+		expected := fmt.Sprintf("has key %s", keyTm.String(key))
+		actual := fmt.Sprintf("key %s not found", keyTm.String(key))
+		detail.Text = matchfmt.ActualVsExpected(actual, expected)
+		return false, detail
+	}
+	inner := Describe(value, matcher)
+	detail.Node = &inner
+	return inner.Matched, detail
+}
+
+func mapEntryImpl[T, K, V any](containerTm typemap.GetValue[T, K, V], keyTm typemap.String[K], name string, key K, matcher Matcher[V]) Matcher[T] {
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(matcher),
+		describe: func(got T) matchfmt.Node {
+			matched, detail := mapEntryDetail(containerTm, keyTm, got, key, matcher)
+			return matchfmt.Node{
+				Matched: matched,
+				Name:    name,
+				Details: []matchfmt.Detail{detail},
+			}
+		},
+	}
+}
+
+func MapEntryTm[T, K, V any](containerTm typemap.GetValue[T, K, V], keyTm typemap.String[K], key K, matcher Matcher[V]) Matcher[T] {
+	return mapEntryImpl(containerTm, keyTm, "match.MapEntryTm", key, matcher)
+}
+
+func MapLikeEntry[T ~map[K]V, K comparable, V any](key K, matcher Matcher[V]) Matcher[T] {
+	contTm := typemap.ForMapLike[T, K, V]{}
+	keyTm := struct {
+		typemap.StringFunc[K]
+	}{
+		StringFunc: DefaultString[K],
+	}
+	return mapEntryImpl(contTm, keyTm, "match.MapLikeEntry", key, matcher)
+}
+
+func MapEntry[K comparable, V any](key K, matcher Matcher[V]) Matcher[map[K]V] {
+	contTm := typemap.ForMap[K, V]{}
+	keyTm := struct {
+		typemap.StringFunc[K]
+	}{
+		StringFunc: DefaultString[K],
+	}
+	return mapEntryImpl(contTm, keyTm, "match.MapEntry", key, matcher)
+}
+
+func mapContainsEntriesImpl[T any, K comparable, V any](containerTm typemap.GetValue[T, K, V], keyTm typemap.String[K], name string, entries map[K]Matcher[V]) Matcher[T] {
+	keys := make([]K, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sortKeysByString(keyTm, keys)
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(slices.Collect(maps.Values(entries))...),
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{Matched: true, Name: name}
+			var failing []K
+			for _, key := range keys {
+				matched, detail := mapEntryDetail(containerTm, keyTm, got, key, entries[key])
+				if !matched {
+					node.Matched = false
+					failing = append(failing, key)
+				}
+				node.Details = append(node.Details, detail)
+			}
+			if !node.Matched {
+				summary := matchfmt.Detail{Text: fmt.Sprintf("failing keys: %s", keyList(keyTm, failing))}
+				node.Details = append([]matchfmt.Detail{summary}, node.Details...)
+			}
+			return node
+		},
+	}
+}
+
+func MapContainsEntriesTm[T any, K comparable, V any](containerTm typemap.GetValue[T, K, V], keyTm typemap.String[K], entries map[K]Matcher[V]) Matcher[T] {
+	return mapContainsEntriesImpl(containerTm, keyTm, "match.MapContainsEntriesTm", entries)
+}
+
+func MapLikeContainsEntries[T ~map[K]V, K comparable, V any](entries map[K]Matcher[V]) Matcher[T] {
+	contTm := typemap.ForMapLike[T, K, V]{}
+	keyTm := struct {
+		typemap.StringFunc[K]
+	}{
+		StringFunc: DefaultString[K],
+	}
+	return mapContainsEntriesImpl(contTm, keyTm, "match.MapLikeContainsEntries", entries)
+}
+
+func MapContainsEntries[K comparable, V any](entries map[K]Matcher[V]) Matcher[map[K]V] {
+	contTm := typemap.ForMap[K, V]{}
+	keyTm := struct {
+		typemap.StringFunc[K]
+	}{
+		StringFunc: DefaultString[K],
+	}
+	return mapContainsEntriesImpl(contTm, keyTm, "match.MapContainsEntries", entries)
+}
+
+func mapKeysAreImpl[T, K any](containerTm typemap.AllKeys[T, K], keyTm typemap.Order[K], name string, matcher Matcher[[]K]) Matcher[T] {
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(matcher),
+		describe: func(got T) matchfmt.Node {
+			keys := slices.SortedFunc(containerTm.AllKeys(got), keyTm.Order)
+			inner := Describe(keys, matcher)
+			return matchfmt.Node{
+				Matched: inner.Matched,
+				Name:    name,
+				Details: []matchfmt.Detail{{Node: &inner}},
+			}
+		},
+	}
+}
+
+func MapKeysAreTm[T, K any](containerTm typemap.AllKeys[T, K], keyTm typemap.Order[K], matcher Matcher[[]K]) Matcher[T] {
+	return mapKeysAreImpl(containerTm, keyTm, "match.MapKeysAreTm", matcher)
+}
+
+func MapLikeKeysAre[T ~map[K]V, K cmp.Ordered, V any](matcher Matcher[[]K]) Matcher[T] {
+	contTm := typemap.ForMapLike[T, K, V]{}
+	keyTm := typemap.DefaultOrder[K]{}
+	return mapKeysAreImpl(contTm, keyTm, "match.MapLikeKeysAre", matcher)
+}
+
+func MapKeysAre[K cmp.Ordered, V any](matcher Matcher[[]K]) Matcher[map[K]V] {
+	contTm := typemap.ForMap[K, V]{}
+	keyTm := typemap.DefaultOrder[K]{}
+	return mapKeysAreImpl(contTm, keyTm, "match.MapKeysAre", matcher)
+}
+
+func mapValuesEachImpl[T, K, V any](containerTm typemap.AllKeyValues[T, K, V], keyTm typemap.String[K], name string, matcher Matcher[V]) Matcher[T] {
+	type failure struct {
+		key  K
+		node matchfmt.Node
+	}
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(matcher),
+		matches: func(got T) bool {
+			for _, value := range containerTm.AllKeyValues(got) {
+				if !Matches(value, matcher) {
+					return false
+				}
+			}
+			return true
+		},
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{Matched: true, Name: name}
+			count := 0
+			var failures []failure
+			for key, value := range containerTm.AllKeyValues(got) {
+				count++
+				if inner := Describe(value, matcher); !inner.Matched {
+					node.Matched = false
+					failures = append(failures, failure{key: key, node: inner})
+				}
+			}
+			if node.Matched {
+				node.Expected = fmt.Sprintf("all %d values match", count)
+				return node
+			}
+			slices.SortFunc(failures, func(a, b failure) int {
+				return cmp.Compare(keyTm.String(a.key), keyTm.String(b.key))
+			})
+			failing := make([]K, len(failures))
+			for i, f := range failures {
+				failing[i] = f.key
+			}
+			node.Details = []matchfmt.Detail{{Text: fmt.Sprintf("failing keys: %s", keyList(keyTm, failing))}}
+			for i := range failures {
+				node.Details = append(node.Details, matchfmt.Detail{
+					Label: fmt.Sprintf("key %s:", keyTm.String(failures[i].key)),
+					Node:  &failures[i].node,
+				})
+			}
+			return node
+		},
+	}
+}
+
+func MapValuesEachTm[T, K, V any](containerTm typemap.AllKeyValues[T, K, V], keyTm typemap.String[K], matcher Matcher[V]) Matcher[T] {
+	return mapValuesEachImpl(containerTm, keyTm, "match.MapValuesEachTm", matcher)
+}
+
+func MapLikeValuesEach[T ~map[K]V, K comparable, V any](matcher Matcher[V]) Matcher[T] {
+	contTm := typemap.ForMapLike[T, K, V]{}
+	keyTm := struct {
+		typemap.StringFunc[K]
+	}{
+		StringFunc: DefaultString[K],
+	}
+	return mapValuesEachImpl(contTm, keyTm, "match.MapLikeValuesEach", matcher)
+}
+
+func MapValuesEach[K comparable, V any](matcher Matcher[V]) Matcher[map[K]V] {
+	contTm := typemap.ForMap[K, V]{}
+	keyTm := struct {
+		typemap.StringFunc[K]
+	}{
+		StringFunc: DefaultString[K],
+	}
+	return mapValuesEachImpl(contTm, keyTm, "match.MapValuesEach", matcher)
+}
@@ -0,0 +1,419 @@
+package match_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-typemap"
+)
+
+func TestMapEntryTm(t *testing.T) {
+	goldie := newGoldie(t)
+	containerTm := typemap.ForMap[string, int]{}
+	keyTm := struct {
+		typemap.StringFunc[string]
+	}{
+		StringFunc: func(key string) string {
+			return fmt.Sprintf("<%s>", key)
+		},
+	}
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string]int]
+		value   map[string]int
+		want    bool
+	}{
+		{
+			name:    "entry_matches",
+			matcher: match.MapEntryTm(containerTm, keyTm, "foo", match.Equal(1)),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    true,
+		},
+		{
+			name:    "entry_does_not_match",
+			matcher: match.MapEntryTm(containerTm, keyTm, "foo", match.Equal(2)),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    false,
+		},
+		{
+			name:    "key_not_found",
+			matcher: match.MapEntryTm(containerTm, keyTm, "baz", match.Equal(1)),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestMapLikeEntry(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string]int]
+		value   map[string]int
+		want    bool
+	}{
+		{
+			name:    "entry_matches",
+			matcher: match.MapLikeEntry[map[string]int]("foo", match.Equal(1)),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    true,
+		},
+		{
+			name:    "key_not_found",
+			matcher: match.MapLikeEntry[map[string]int]("baz", match.Equal(1)),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestMapEntry(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string]int]
+		value   map[string]int
+		want    bool
+	}{
+		{
+			name:    "entry_matches",
+			matcher: match.MapEntry[string]("foo", match.Equal(1)),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    true,
+		},
+		{
+			name:    "entry_does_not_match",
+			matcher: match.MapEntry[string]("bar", match.GreaterThan(5)),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    false,
+		},
+		{
+			name:    "key_not_found",
+			matcher: match.MapEntry[string]("baz", match.Equal(1)),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestMapContainsEntriesTm(t *testing.T) {
+	goldie := newGoldie(t)
+	containerTm := typemap.ForMap[string, int]{}
+	keyTm := struct {
+		typemap.StringFunc[string]
+	}{
+		StringFunc: func(key string) string {
+			return fmt.Sprintf("<%s>", key)
+		},
+	}
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string]int]
+		value   map[string]int
+		want    bool
+	}{
+		{
+			name:    "all_entries_match",
+			matcher: match.MapContainsEntriesTm(containerTm, keyTm, map[string]match.Matcher[int]{"foo": match.Equal(1)}),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    true,
+		},
+		{
+			name:    "key_not_found",
+			matcher: match.MapContainsEntriesTm(containerTm, keyTm, map[string]match.Matcher[int]{"baz": match.Equal(1)}),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestMapLikeContainsEntries(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string]int]
+		value   map[string]int
+		want    bool
+	}{
+		{
+			name:    "all_entries_match",
+			matcher: match.MapLikeContainsEntries[map[string]int](map[string]match.Matcher[int]{"foo": match.Equal(1), "bar": match.Equal(2)}),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    true,
+		},
+		{
+			name:    "some_entries_do_not_match",
+			matcher: match.MapLikeContainsEntries[map[string]int](map[string]match.Matcher[int]{"foo": match.Equal(1), "bar": match.Equal(3)}),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestMapContainsEntries(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string]int]
+		value   map[string]int
+		want    bool
+	}{
+		{
+			name:    "all_entries_match",
+			matcher: match.MapContainsEntries(map[string]match.Matcher[int]{"foo": match.Equal(1), "bar": match.Equal(2)}),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    true,
+		},
+		{
+			name:    "some_entries_do_not_match",
+			matcher: match.MapContainsEntries(map[string]match.Matcher[int]{"foo": match.Equal(5), "bar": match.Equal(2), "baz": match.Equal(3)}),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    false,
+		},
+		{
+			name:    "no_entries",
+			matcher: match.MapContainsEntries(map[string]match.Matcher[int]{}),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+type reverseStringOrder struct{}
+
+func (reverseStringOrder) Order(a, b string) int {
+	return strings.Compare(b, a)
+}
+
+func TestMapKeysAreTm(t *testing.T) {
+	goldie := newGoldie(t)
+	containerTm := typemap.ForMap[string, int]{}
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string]int]
+		value   map[string]int
+		want    bool
+	}{
+		{
+			name:    "keys_in_custom_order",
+			matcher: match.MapKeysAreTm(containerTm, reverseStringOrder{}, match.SliceElementsAre(match.Equal("foo"), match.Equal("bar"))),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestMapLikeKeysAre(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string]int]
+		value   map[string]int
+		want    bool
+	}{
+		{
+			name:    "keys_match",
+			matcher: match.MapLikeKeysAre[map[string]int](match.SliceElementsAre(match.Equal("bar"), match.Equal("foo"))),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestMapKeysAre(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string]int]
+		value   map[string]int
+		want    bool
+	}{
+		{
+			name:    "keys_match",
+			matcher: match.MapKeysAre[string, int](match.SliceElementsAre(match.Equal("a"), match.Equal("b"), match.Equal("c"))),
+			value:   map[string]int{"c": 3, "a": 1, "b": 2},
+			want:    true,
+		},
+		{
+			name:    "keys_do_not_match",
+			matcher: match.MapKeysAre[string, int](match.SliceElementsAre(match.Equal("a"), match.Equal("b"))),
+			value:   map[string]int{"c": 3, "a": 1},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestMapValuesEachTm(t *testing.T) {
+	goldie := newGoldie(t)
+	containerTm := typemap.ForMap[string, int]{}
+	keyTm := struct {
+		typemap.StringFunc[string]
+	}{
+		StringFunc: func(key string) string {
+			return fmt.Sprintf("<%s>", key)
+		},
+	}
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string]int]
+		value   map[string]int
+		want    bool
+	}{
+		{
+			name:    "some_values_do_not_match",
+			matcher: match.MapValuesEachTm(containerTm, keyTm, match.LessThan(2)),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestMapLikeValuesEach(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string]int]
+		value   map[string]int
+		want    bool
+	}{
+		{
+			name:    "all_values_match",
+			matcher: match.MapLikeValuesEach[map[string]int](match.GreaterThan(0)),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestMapValuesEach(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[map[string]int]
+		value   map[string]int
+		want    bool
+	}{
+		{
+			name:    "all_values_match",
+			matcher: match.MapValuesEach[string](match.GreaterThan(0)),
+			value:   map[string]int{"foo": 1, "bar": 2},
+			want:    true,
+		},
+		{
+			name:    "some_values_do_not_match",
+			matcher: match.MapValuesEach[string](match.GreaterThan(1)),
+			value:   map[string]int{"c": 0, "a": 1, "b": 2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
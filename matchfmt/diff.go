@@ -0,0 +1,271 @@
+package matchfmt
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// diffLines returns a shortest edit script turning a into b, using Myers'
+// O(ND) algorithm in linear space, so that large texts with scattered changes
+// do not need memory proportional to the product of their lengths.  Within a
+// run of changes, deletions come before insertions.
+func diffLines(a, b []string) []diffOp {
+	ids := map[string]int{}
+	intern := func(lines []string) []int {
+		out := make([]int, len(lines))
+		for i, line := range lines {
+			id, ok := ids[line]
+			if !ok {
+				id = len(ids)
+				ids[line] = id
+			}
+			out[i] = id
+		}
+		return out
+	}
+	d := &differ{a: a, b: b, ops: make([]diffOp, 0, len(a)+len(b))}
+	d.diff(intern(a), intern(b), 0, 0)
+
+	// Order each run of changes as deletions followed by insertions.
+	ops := d.ops
+	for start := 0; start < len(ops); {
+		if ops[start].kind == ' ' {
+			start++
+			continue
+		}
+		end := start
+		for end < len(ops) && ops[end].kind != ' ' {
+			end++
+		}
+		slices.SortStableFunc(ops[start:end], func(x, y diffOp) int {
+			// '-' is greater than '+', so sort in descending order.
+			return cmp.Compare(y.kind, x.kind)
+		})
+		start = end
+	}
+	return ops
+}
+
+// differ accumulates the edit script for a and b, whose lines are compared
+// through integer ids.
+type differ struct {
+	a, b []string
+	ops  []diffOp
+}
+
+// diff appends the edit script turning x into y, which start at line aOff of
+// a and line bOff of b.
+func (d *differ) diff(x, y []int, aOff, bOff int) {
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		d.ops = append(d.ops, diffOp{' ', d.a[aOff+prefix]})
+		prefix++
+	}
+	x, y, aOff, bOff = x[prefix:], y[prefix:], aOff+prefix, bOff+prefix
+	suffix := 0
+	for suffix < len(x) && suffix < len(y) && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+	x, y = x[:len(x)-suffix], y[:len(y)-suffix]
+	suffixOff := aOff + len(x)
+
+	if len(x) > 0 && len(y) > 0 {
+		if i, j, ok := middleSnake(x, y); ok {
+			d.diff(x[:i], y[:j], aOff, bOff)
+			d.diff(x[i:], y[j:], aOff+i, bOff+j)
+			x, y = nil, nil
+		}
+	}
+	for i := range x {
+		d.ops = append(d.ops, diffOp{'-', d.a[aOff+i]})
+	}
+	for j := range y {
+		d.ops = append(d.ops, diffOp{'+', d.b[bOff+j]})
+	}
+	for i := range suffix {
+		d.ops = append(d.ops, diffOp{' ', d.a[suffixOff+i]})
+	}
+}
+
+// middleSnake finds the middle of a shortest edit script turning x into y by
+// searching forward from the start and backward from the end at once, and
+// returns the point where the searches meet, which splits the problem in two.
+// x and y must be non-empty and differ in their first and last lines.
+func middleSnake(x, y []int) (i, j int, ok bool) {
+	n, m := len(x), len(y)
+	maxD := (n + m + 1) / 2
+	off := maxD + 1
+	// forward[off+k] is the furthest line of x reached on diagonal k, where
+	// k is the line of x minus the line of y.  backward is the same for x
+	// and y read from the end.
+	forward := make([]int, 2*maxD+3)
+	backward := make([]int, 2*maxD+3)
+	for k := range forward {
+		forward[k], backward[k] = -1, -1
+	}
+	forward[off+1], backward[off+1] = 0, 0
+	delta := n - m
+	odd := delta%2 != 0
+	// Diagonals that leave the edit graph are excluded by narrowing the
+	// range of k from either end.
+	var fStart, fEnd, bStart, bEnd int
+	for d := 0; d <= maxD; d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			var xi int
+			if k == -d || (k != d && forward[off+k-1] < forward[off+k+1]) {
+				xi = forward[off+k+1]
+			} else {
+				xi = forward[off+k-1] + 1
+			}
+			yi := xi - k
+			for xi < n && yi < m && x[xi] == y[yi] {
+				xi++
+				yi++
+			}
+			forward[off+k] = xi
+			switch {
+			case xi > n:
+				fEnd += 2
+			case yi > m:
+				fStart += 2
+			case odd:
+				if bk := off + delta - k; bk >= 0 && bk < len(backward) && backward[bk] != -1 && xi >= n-backward[bk] {
+					return xi, yi, true
+				}
+			}
+		}
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			var xi int
+			if k == -d || (k != d && backward[off+k-1] < backward[off+k+1]) {
+				xi = backward[off+k+1]
+			} else {
+				xi = backward[off+k-1] + 1
+			}
+			yi := xi - k
+			for xi < n && yi < m && x[n-1-xi] == y[m-1-yi] {
+				xi++
+				yi++
+			}
+			backward[off+k] = xi
+			switch {
+			case xi > n:
+				bEnd += 2
+			case yi > m:
+				bStart += 2
+			case !odd:
+				if fk := off + delta - k; fk >= 0 && fk < len(forward) && forward[fk] != -1 {
+					fx := forward[fk]
+					if fx >= n-xi {
+						return fx, fx - (fk - off), true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// noEOL marks the last line of a text that, unlike the text it is compared
+// with, does not end in a newline.  Lines never contain a newline, so the
+// marked line differs from the same line in the other text.
+const noEOL = "\n"
+
+// splitLines splits s into lines the way diff(1) does: a final newline ends
+// the last line rather than starting an empty one.
+func splitLines(s string, markNoEOL bool) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	if markNoEOL {
+		lines[len(lines)-1] += noEOL
+	}
+	return lines
+}
+
+// UnifiedDiff returns a line-based unified diff from want to got, with the
+// given number of unchanged context lines around each change; a negative
+// context is treated as 0.  Lines only in want are prefixed with "-" and
+// lines only in got with "+".  As in diff(1), a final newline ends the last
+// line, and if only one of want and got ends in a newline, the other's last
+// line is followed by "\ No newline at end of file".  It returns the empty
+// string if want and got are equal.
+func UnifiedDiff(want, got string, context int) string {
+	if want == got {
+		return ""
+	}
+	context = max(context, 0)
+	wantEOL, gotEOL := strings.HasSuffix(want, "\n"), strings.HasSuffix(got, "\n")
+	ops := diffLines(splitLines(want, gotEOL && !wantEOL), splitLines(got, wantEOL && !gotEOL))
+
+	sb := &strings.Builder{}
+	sb.WriteString("--- want\n+++ got")
+	// aLine and bLine are the 0-based line numbers in want and got of ops[k].
+	aLine, bLine := make([]int, len(ops)+1), make([]int, len(ops)+1)
+	for k, op := range ops {
+		aLine[k+1], bLine[k+1] = aLine[k], bLine[k]
+		if op.kind != '+' {
+			aLine[k+1]++
+		}
+		if op.kind != '-' {
+			bLine[k+1]++
+		}
+	}
+	for k := 0; k < len(ops); {
+		if ops[k].kind == ' ' {
+			k++
+			continue
+		}
+		// Extend the hunk until more than 2*context unchanged lines separate
+		// it from the next change.
+		start := max(0, k-context)
+		end := k
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			run := end
+			for run < len(ops) && ops[run].kind == ' ' {
+				run++
+			}
+			if run == len(ops) || run-end > 2*context {
+				end = min(end+context, len(ops))
+				break
+			}
+			end = run
+		}
+		fmt.Fprintf(sb, "\n@@ -%s +%s @@",
+			hunkRange(aLine[start], aLine[end]-aLine[start]),
+			hunkRange(bLine[start], bLine[end]-bLine[start]))
+		for _, op := range ops[start:end] {
+			sb.WriteString("\n")
+			sb.WriteByte(op.kind)
+			if line, ok := strings.CutSuffix(op.line, noEOL); ok {
+				sb.WriteString(line)
+				sb.WriteString("\n\\ No newline at end of file")
+			} else {
+				sb.WriteString(op.line)
+			}
+		}
+		k = end
+	}
+	return sb.String()
+}
@@ -0,0 +1,170 @@
+package matchfmt_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		want     string
+		got      string
+		context  int
+		expected string
+	}{
+		{
+			name:     "equal",
+			want:     "a\nb",
+			got:      "a\nb",
+			context:  3,
+			expected: "",
+		},
+		{
+			name:    "changed line",
+			want:    "a\nb\nc",
+			got:     "a\nx\nc",
+			context: 3,
+			expected: strings.Join([]string{
+				"--- want",
+				"+++ got",
+				"@@ -1,3 +1,3 @@",
+				" a",
+				"-b",
+				"+x",
+				" c",
+			}, "\n"),
+		},
+		{
+			name:    "limited context",
+			want:    "1\n2\n3\n4\n5\n6\n7",
+			got:     "1\n2\n3\nfour\n5\n6\n7",
+			context: 1,
+			expected: strings.Join([]string{
+				"--- want",
+				"+++ got",
+				"@@ -3,3 +3,3 @@",
+				" 3",
+				"-4",
+				"+four",
+				" 5",
+			}, "\n"),
+		},
+		{
+			name:    "separate hunks",
+			want:    "a\n1\n2\n3\n4\nb",
+			got:     "A\n1\n2\n3\n4\nB",
+			context: 1,
+			expected: strings.Join([]string{
+				"--- want",
+				"+++ got",
+				"@@ -1,2 +1,2 @@",
+				"-a",
+				"+A",
+				" 1",
+				"@@ -5,2 +5,2 @@",
+				" 4",
+				"-b",
+				"+B",
+			}, "\n"),
+		},
+		{
+			name:    "insertion and deletion",
+			want:    "a\nb\nc",
+			got:     "b\nc\nd",
+			context: 0,
+			expected: strings.Join([]string{
+				"--- want",
+				"+++ got",
+				"@@ -1 +0,0 @@",
+				"-a",
+				"@@ -3,0 +3 @@",
+				"+d",
+			}, "\n"),
+		},
+		{
+			name:    "negative context",
+			want:    "a\nb\nc",
+			got:     "a\nx\nc",
+			context: -1,
+			expected: strings.Join([]string{
+				"--- want",
+				"+++ got",
+				"@@ -2 +2 @@",
+				"-b",
+				"+x",
+			}, "\n"),
+		},
+		{
+			name:    "final newline ends the last line",
+			want:    "a\n",
+			got:     "a\nb\n",
+			context: 3,
+			expected: strings.Join([]string{
+				"--- want",
+				"+++ got",
+				"@@ -1 +1,2 @@",
+				" a",
+				"+b",
+			}, "\n"),
+		},
+		{
+			name:    "empty want",
+			want:    "",
+			got:     "a\n",
+			context: 3,
+			expected: strings.Join([]string{
+				"--- want",
+				"+++ got",
+				"@@ -0,0 +1 @@",
+				"+a",
+			}, "\n"),
+		},
+		{
+			name:    "missing final newline",
+			want:    "a\nb\n",
+			got:     "a\nb",
+			context: 3,
+			expected: strings.Join([]string{
+				"--- want",
+				"+++ got",
+				"@@ -1,2 +1,2 @@",
+				" a",
+				"-b",
+				"+b",
+				"\\ No newline at end of file",
+			}, "\n"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := matchfmt.UnifiedDiff(tt.want, tt.got, tt.context)
+			if result != tt.expected {
+				t.Errorf("UnifiedDiff() =\n%s\nwant\n%s", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnifiedDiffLargeInput(t *testing.T) {
+	const n = 20000
+	want, got := make([]string, n), make([]string, n)
+	for i := range n {
+		want[i] = fmt.Sprintf("key%d = %d", i, i)
+		got[i] = want[i]
+		if i%100 == 50 {
+			got[i] += " # changed"
+		}
+	}
+	result := matchfmt.UnifiedDiff(strings.Join(want, "\n"), strings.Join(got, "\n"), 3)
+	if hunks := strings.Count(result, "\n@@ "); hunks != n/100 {
+		t.Errorf("UnifiedDiff() has %d hunks, want %d", hunks, n/100)
+	}
+	if changes := strings.Count(result, "\n+key"); changes != n/100 {
+		t.Errorf("UnifiedDiff() has %d added lines, want %d", changes, n/100)
+	}
+}
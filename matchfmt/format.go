@@ -14,6 +14,8 @@
 //   - Text indentation for hierarchical output
 //   - Formatted explanations with details
 //   - Actual vs expected value comparisons
+//   - Structured explanation trees that render to the same text
+//   - Line-based unified diffs of multi-line text
 package matchfmt
 
 import (
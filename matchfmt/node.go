@@ -0,0 +1,121 @@
+package matchfmt
+
+// Node is a structured matcher explanation.  Each node records the matcher
+// that produced it, whether it matched, and the details beneath it, some of
+// which may be nested nodes from inner matchers.  String renders the node to
+// the same text that Explain and ActualVsExpected produce, so matchers can
+// build a Node and still return a plain explanation string.
+type Node struct {
+	Matched bool
+	Name    string
+
+	// Expected describes what the matcher wanted.  When Actual is also set,
+	// the two are rendered as an ActualVsExpected pair; otherwise Expected is
+	// rendered on its own.
+	Expected string
+	Actual   string
+
+	// Details are rendered in order after Expected and Actual.
+	Details []Detail
+
+	raw    string
+	hasRaw bool
+}
+
+// Detail is one entry in a Node's details.  A Detail may have a Label, which
+// is rendered on its own line with the Text, Node and Details indented
+// beneath it.  Text is free-form and may span several lines.  Node is an
+// inner matcher's explanation.  Details are nested entries, for grouping
+// several labelled entries under one label.
+type Detail struct {
+	Label   string
+	Text    string
+	Node    *Node
+	Details []Detail
+}
+
+// Raw returns a Node for an explanation that is only available as text, for
+// example one returned by a matcher that does not build Nodes.  It renders
+// as the text unchanged.
+func Raw(matched bool, text string) Node {
+	return Node{
+		Matched: matched,
+		raw:     text,
+		hasRaw:  true,
+	}
+}
+
+// IsRaw reports whether n was created by Raw.
+func (n Node) IsRaw() bool {
+	return n.hasRaw
+}
+
+func (d Detail) strings() []string {
+	var out []string
+	level := 0
+	if d.Label != "" {
+		out = append(out, d.Label)
+		level = 1
+	}
+	if d.Text != "" {
+		out = append(out, IndentBy(d.Text, level))
+	}
+	if d.Node != nil {
+		out = append(out, IndentBy(d.Node.String(), level))
+	}
+	for _, nested := range d.Details {
+		for _, line := range nested.strings() {
+			out = append(out, IndentBy(line, level))
+		}
+	}
+	return out
+}
+
+// failingLeaves returns the failing leaves of the nodes in d and its nested
+// details.
+func (d Detail) failingLeaves() []Node {
+	var leaves []Node
+	if d.Node != nil {
+		leaves = append(leaves, d.Node.FailingLeaves()...)
+	}
+	for _, nested := range d.Details {
+		leaves = append(leaves, nested.failingLeaves()...)
+	}
+	return leaves
+}
+
+// String renders n in the same format as Explain.
+func (n Node) String() string {
+	if n.hasRaw {
+		return n.raw
+	}
+	details := make([]string, 0, len(n.Details)+1)
+	if n.Expected != "" {
+		if n.Actual != "" {
+			details = append(details, ActualVsExpected(n.Actual, n.Expected))
+		} else {
+			details = append(details, n.Expected)
+		}
+	}
+	for _, d := range n.Details {
+		details = append(details, d.strings()...)
+	}
+	return Explain(n.Matched, n.Name, details...)
+}
+
+// FailingLeaves returns the deepest nodes in the tree that did not match.  A
+// node that did not match is a failing leaf if none of its nested nodes also
+// failed.  It returns nil if n matched.
+func (n Node) FailingLeaves() []Node {
+	if n.Matched {
+		return nil
+	}
+	var leaves []Node
+	for _, d := range n.Details {
+		leaves = append(leaves, d.failingLeaves()...)
+	}
+	if len(leaves) == 0 {
+		return []Node{n}
+	}
+	return leaves
+}
@@ -0,0 +1,142 @@
+package matchfmt_test
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+func TestNodeString(t *testing.T) {
+	inner := matchfmt.Node{
+		Name:     "match.Equal",
+		Expected: "got == 2",
+		Actual:   "got == 1",
+	}
+	tests := []struct {
+		name     string
+		node     matchfmt.Node
+		expected string
+	}{
+		{
+			name:     "expected only",
+			node:     matchfmt.Node{Matched: true, Name: "match.Equal", Expected: "got == 1"},
+			expected: matchfmt.Explain(true, "match.Equal", "got == 1"),
+		},
+		{
+			name:     "expected and actual",
+			node:     inner,
+			expected: matchfmt.Explain(false, "match.Equal", matchfmt.ActualVsExpected("got == 1", "got == 2")),
+		},
+		{
+			name: "labelled nested node",
+			node: matchfmt.Node{
+				Name: "match.AllOf",
+				Details: []matchfmt.Detail{
+					{Text: "failing indices: [0]"},
+					{Label: "index 0:", Node: &inner},
+				},
+			},
+			expected: matchfmt.Explain(false, "match.AllOf",
+				"failing indices: [0]",
+				"index 0:",
+				matchfmt.Indent(inner.String()),
+			),
+		},
+		{
+			name: "nested details",
+			node: matchfmt.Node{
+				Name: "match.SliceUnorderedElementsAre",
+				Details: []matchfmt.Detail{{
+					Label: "matcher 1:",
+					Details: []matchfmt.Detail{
+						{Label: "index 0:", Node: &inner},
+						{Label: "index 2:", Text: "no value"},
+					},
+				}},
+			},
+			expected: matchfmt.Explain(false, "match.SliceUnorderedElementsAre",
+				"matcher 1:",
+				matchfmt.Indent("index 0:"),
+				matchfmt.IndentBy(inner.String(), 2),
+				matchfmt.Indent("index 2:"),
+				matchfmt.IndentBy("no value", 2),
+			),
+		},
+		{
+			name:     "raw",
+			node:     matchfmt.Raw(false, "some text"),
+			expected: "some text",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.node.String()
+			if result != tt.expected {
+				t.Errorf("String() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNodeFailingLeaves(t *testing.T) {
+	passing := matchfmt.Node{Matched: true, Name: "match.Equal"}
+	failing := matchfmt.Node{Name: "match.LessThan"}
+	nested := matchfmt.Node{
+		Name:    "match.AnyOf",
+		Details: []matchfmt.Detail{{Node: &failing}},
+	}
+	tests := []struct {
+		name     string
+		node     matchfmt.Node
+		expected []string
+	}{
+		{
+			name:     "matched node has no failing leaves",
+			node:     passing,
+			expected: nil,
+		},
+		{
+			name:     "failing node without children is a leaf",
+			node:     failing,
+			expected: []string{"match.LessThan"},
+		},
+		{
+			name: "deepest failures are returned",
+			node: matchfmt.Node{
+				Name: "match.AllOf",
+				Details: []matchfmt.Detail{
+					{Node: &passing},
+					{Node: &nested},
+					{Node: &failing},
+				},
+			},
+			expected: []string{"match.LessThan", "match.LessThan"},
+		},
+		{
+			name: "nested details are searched",
+			node: matchfmt.Node{
+				Name: "match.SliceUnorderedElementsAre",
+				Details: []matchfmt.Detail{{
+					Label:   "matcher 0:",
+					Details: []matchfmt.Detail{{Node: &passing}, {Node: &nested}},
+				}},
+			},
+			expected: []string{"match.LessThan"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leaves := tt.node.FailingLeaves()
+			if len(leaves) != len(tt.expected) {
+				t.Fatalf("FailingLeaves() returned %d nodes, want %d", len(leaves), len(tt.expected))
+			}
+			for i, leaf := range leaves {
+				if leaf.Name != tt.expected[i] {
+					t.Errorf("leaf %d name = %q, want %q", i, leaf.Name, tt.expected[i])
+				}
+			}
+		})
+	}
+}
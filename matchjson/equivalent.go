@@ -0,0 +1,56 @@
+package matchjson
+
+import (
+	"fmt"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+// invalidNode reports a document that could not be decoded.
+func invalidNode(name, which string, err error) matchfmt.Node {
+	return matchfmt.Node{
+		Name:     name,
+		Expected: fmt.Sprintf("%s is valid JSON", which),
+		Actual:   fmt.Sprintf("%s is not valid JSON: %v", which, err),
+	}
+}
+
+func describeFunc[T Doc](describe func(got T) matchfmt.Node) match.Matcher[T] {
+	return match.MatcherFunc[T](func(got T) (bool, string) {
+		node := describe(got)
+		return node.Matched, node.String()
+	})
+}
+
+// JSONEquivalent matches documents that decode to the same value as want.
+// Object key order and whitespace are ignored, and numbers are compared by
+// value, so 1, 1.0 and 1e0 are all equivalent.
+func JSONEquivalent[T Doc](want T) match.Matcher[T] {
+	const name = "matchjson.JSONEquivalent"
+	wantValue, wantErr := decode(want)
+	return describeFunc(func(got T) matchfmt.Node {
+		if wantErr != nil {
+			return invalidNode(name, "want", wantErr)
+		}
+		gotValue, err := decode(got)
+		if err != nil {
+			return invalidNode(name, "got", err)
+		}
+		d := firstDivergence("", gotValue, wantValue)
+		if d == nil {
+			return matchfmt.Node{
+				Matched:  true,
+				Name:     name,
+				Expected: "got is equivalent to want",
+			}
+		}
+		return matchfmt.Node{
+			Name: name,
+			Details: []matchfmt.Detail{{
+				Label: fmt.Sprintf("first divergence at JSON pointer %s:", pointerString(d.pointer)),
+				Text:  matchfmt.ActualVsExpected(d.actual, d.expected),
+			}},
+		}
+	})
+}
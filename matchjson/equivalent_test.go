@@ -0,0 +1,99 @@
+package matchjson_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-match/matchjson"
+)
+
+func TestJSONEquivalent(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "key_order_and_whitespace",
+			matcher: matchjson.JSONEquivalent(`{"a": 1, "b": [true, null]}`),
+			value:   "{\n  \"b\": [true, null],\n  \"a\": 1\n}",
+			want:    true,
+		},
+		{
+			name:    "numbers_compared_numerically",
+			matcher: matchjson.JSONEquivalent(`{"price": 1.50, "count": 1e2}`),
+			value:   `{"price": 1.5, "count": 100}`,
+			want:    true,
+		},
+		{
+			name:    "large_numbers_are_exact",
+			matcher: matchjson.JSONEquivalent(`9007199254740993`),
+			value:   `9007199254740992`,
+			want:    false,
+		},
+		{
+			name:    "nested_value_differs",
+			matcher: matchjson.JSONEquivalent(`{"items": [{"price": 3}, {"price": 4}]}`),
+			value:   `{"items": [{"price": 3}, {"price": 5}]}`,
+			want:    false,
+		},
+		{
+			name:    "missing_key",
+			matcher: matchjson.JSONEquivalent(`{"a/b": 1, "c": 2}`),
+			value:   `{"c": 2}`,
+			want:    false,
+		},
+		{
+			name:    "extra_key",
+			matcher: matchjson.JSONEquivalent(`{"a": 1}`),
+			value:   `{"a": 1, "b": 2}`,
+			want:    false,
+		},
+		{
+			name:    "array_length",
+			matcher: matchjson.JSONEquivalent(`[1, 2, 3]`),
+			value:   `[1, 2]`,
+			want:    false,
+		},
+		{
+			name:    "type_differs",
+			matcher: matchjson.JSONEquivalent(`{"a": "1"}`),
+			value:   `{"a": 1}`,
+			want:    false,
+		},
+		{
+			name:    "invalid_got",
+			matcher: matchjson.JSONEquivalent(`{}`),
+			value:   `{`,
+			want:    false,
+		},
+		{
+			name:    "invalid_want",
+			matcher: matchjson.JSONEquivalent(`{} {}`),
+			value:   `{}`,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestJSONEquivalentBytes(t *testing.T) {
+	raw := json.RawMessage(`{"a": [1, 2]}`)
+	if matched, explanation := matchjson.JSONEquivalent(json.RawMessage(`{"a":[1,2]}`)).Match(raw); !matched {
+		t.Errorf("json.RawMessage: expected match, got:\n%s", explanation)
+	}
+	if matched, explanation := matchjson.JSONEquivalent([]byte(`{"a":[1,2]}`)).Match([]byte(raw)); !matched {
+		t.Errorf("[]byte: expected match, got:\n%s", explanation)
+	}
+}
@@ -0,0 +1,13 @@
+package matchjson_test
+
+import (
+	"testing"
+
+	"github.com/sebdah/goldie/v2"
+)
+
+func newGoldie(t *testing.T) *goldie.Goldie {
+	return goldie.New(t,
+		goldie.WithTestNameForDir(true),
+	)
+}
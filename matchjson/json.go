@@ -0,0 +1,145 @@
+// Package matchjson provides matchers for JSON documents.
+//
+// The matchers accept any string or byte slice type, including
+// json.RawMessage, and compare documents by their decoded values rather than
+// their text, so key order and whitespace do not matter.  Failures report
+// the JSON pointer (RFC 6901) of the first place the documents diverge.
+package matchjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Doc is the set of types the matchers in this package accept.
+type Doc interface {
+	~string | ~[]byte
+}
+
+// decode parses a single JSON value.  Numbers are decoded as json.Number so
+// that they can be compared exactly.
+func decode[T Doc](doc T) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(doc)))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after top-level value")
+	}
+	return v, nil
+}
+
+// pointerToken escapes a single reference token as described in RFC 6901.
+func pointerToken(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
+
+func pointerString(pointer string) string {
+	return strconv.Quote(pointer)
+}
+
+// compact renders v as compact JSON with sorted keys.
+func compact(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+func numbersEqual(a, b json.Number) bool {
+	ra, okA := new(big.Rat).SetString(string(a))
+	rb, okB := new(big.Rat).SetString(string(b))
+	if !okA || !okB {
+		return a == b
+	}
+	return ra.Cmp(rb) == 0
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// divergence describes the first place two JSON values differ.
+type divergence struct {
+	pointer  string
+	expected string
+	actual   string
+}
+
+// firstDivergence walks got and want and returns the first place they differ,
+// or nil if they are equivalent.  Array elements are visited in order and
+// object keys in sorted order, so the divergence reported within an object is
+// at its lexically first differing key.
+func firstDivergence(pointer string, got, want any) *divergence {
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			return &divergence{pointer, compact(want), compact(got)}
+		}
+		keys := sortedKeys(w)
+		for _, key := range sortedKeys(g) {
+			if _, ok := w[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			child := pointer + "/" + pointerToken(key)
+			gv, inGot := g[key]
+			wv, inWant := w[key]
+			switch {
+			case !inGot:
+				return &divergence{child, compact(wv), "key not present"}
+			case !inWant:
+				return &divergence{child, "key not present", compact(gv)}
+			}
+			if d := firstDivergence(child, gv, wv); d != nil {
+				return d
+			}
+		}
+		return nil
+	case []any:
+		g, ok := got.([]any)
+		if !ok {
+			return &divergence{pointer, compact(want), compact(got)}
+		}
+		for i := range min(len(g), len(w)) {
+			if d := firstDivergence(pointer+"/"+strconv.Itoa(i), g[i], w[i]); d != nil {
+				return d
+			}
+		}
+		if len(g) != len(w) {
+			return &divergence{
+				pointer,
+				fmt.Sprintf("array of length %d", len(w)),
+				fmt.Sprintf("array of length %d", len(g)),
+			}
+		}
+		return nil
+	case json.Number:
+		if g, ok := got.(json.Number); ok && numbersEqual(g, w) {
+			return nil
+		}
+		return &divergence{pointer, compact(want), compact(got)}
+	default:
+		if got == want {
+			return nil
+		}
+		return &divergence{pointer, compact(want), compact(got)}
+	}
+}
@@ -0,0 +1,44 @@
+package matchjson
+
+import (
+	"fmt"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+// JSONHasKeys matches documents whose top-level value is an object with all
+// of the given keys.  Other keys are allowed.
+func JSONHasKeys[T Doc](keys ...string) match.Matcher[T] {
+	const name = "matchjson.JSONHasKeys"
+	return describeFunc(func(got T) matchfmt.Node {
+		gotValue, err := decode(got)
+		if err != nil {
+			return invalidNode(name, "got", err)
+		}
+		node := matchfmt.Node{
+			Name:     name,
+			Expected: fmt.Sprintf("object with keys %q", keys),
+		}
+		obj, ok := gotValue.(map[string]any)
+		if !ok {
+			node.Actual = fmt.Sprintf("%s is not an object", compact(gotValue))
+			return node
+		}
+		var missing []string
+		for _, key := range keys {
+			if _, ok := obj[key]; !ok {
+				missing = append(missing, key)
+			}
+		}
+		if len(missing) == 0 {
+			node.Matched = true
+			return node
+		}
+		node.Actual = fmt.Sprintf("missing keys %q", missing)
+		node.Details = []matchfmt.Detail{{
+			Text: fmt.Sprintf("first divergence at JSON pointer %s", pointerString("/"+pointerToken(missing[0]))),
+		}}
+		return node
+	})
+}
@@ -0,0 +1,158 @@
+package matchjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+// pathStep is one step of a parsed path: either an object key or an array
+// index.
+type pathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parsePath parses the supported subset of JSONPath: a leading "$" followed
+// by any number of ".key", "['key']" and "[index]" steps.
+func parsePath(path string) ([]pathStep, error) {
+	rest, ok := strings.CutPrefix(path, "$")
+	if !ok {
+		return nil, fmt.Errorf("path %q does not start with $", path)
+	}
+	var steps []pathStep
+	for rest != "" {
+		switch {
+		case rest[0] == '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end == -1 {
+				end = len(rest) - 1
+			}
+			key := rest[1 : end+1]
+			if key == "" {
+				return nil, fmt.Errorf("path %q has an empty key", path)
+			}
+			steps = append(steps, pathStep{key: key})
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, "['"):
+			end := strings.Index(rest, "']")
+			if end == -1 {
+				return nil, fmt.Errorf("path %q has an unterminated ['key']", path)
+			}
+			steps = append(steps, pathStep{key: rest[2:end]})
+			rest = rest[end+2:]
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("path %q has an unterminated [index]", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("path %q has an invalid index %q", path, rest[1:end])
+			}
+			steps = append(steps, pathStep{index: index, isIndex: true})
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("path %q has unexpected %q", path, rest)
+		}
+	}
+	return steps, nil
+}
+
+// selectPath follows steps from v.  If a step cannot be followed it returns
+// the JSON pointer of the value it stopped at and the reason.
+func selectPath(v any, steps []pathStep) (selected any, pointer string, missing string) {
+	for _, step := range steps {
+		if step.isIndex {
+			arr, ok := v.([]any)
+			if !ok {
+				return nil, pointer, fmt.Sprintf("%s is not an array", compact(v))
+			}
+			if step.index >= len(arr) {
+				return nil, pointer, fmt.Sprintf("index %d out of range for array of length %d", step.index, len(arr))
+			}
+			v = arr[step.index]
+			pointer += "/" + strconv.Itoa(step.index)
+			continue
+		}
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil, pointer, fmt.Sprintf("%s is not an object", compact(v))
+		}
+		child, ok := obj[step.key]
+		if !ok {
+			return nil, pointer, fmt.Sprintf("key %q not present", step.key)
+		}
+		v = child
+		pointer += "/" + pointerToken(step.key)
+	}
+	return v, pointer, ""
+}
+
+// plain converts json.Number values to float64, the type encoding/json uses
+// by default, so that selected values work with matchers such as
+// match.Equal[any](1.0).
+func plain(v any) any {
+	switch x := v.(type) {
+	case json.Number:
+		f, err := x.Float64()
+		if err != nil {
+			return x.String()
+		}
+		return f
+	case map[string]any:
+		for key, value := range x {
+			x[key] = plain(value)
+		}
+	case []any:
+		for i, value := range x {
+			x[i] = plain(value)
+		}
+	}
+	return v
+}
+
+// JSONPath selects the value at path and matches it against matcher.  The
+// path uses a subset of JSONPath syntax: "$" for the document root, then
+// ".key", "['key']" and "[index]" steps, as in "$.items[0]['unit price']".
+// Selected values have the types encoding/json decodes into an any: numbers
+// are float64, objects are map[string]any and arrays are []any.
+func JSONPath[T Doc](path string, matcher match.Matcher[any]) match.Matcher[T] {
+	const name = "matchjson.JSONPath"
+	steps, pathErr := parsePath(path)
+	return describeFunc(func(got T) matchfmt.Node {
+		if pathErr != nil {
+			return matchfmt.Node{
+				Name:     name,
+				Expected: "valid path",
+				Actual:   pathErr.Error(),
+			}
+		}
+		gotValue, err := decode(got)
+		if err != nil {
+			return invalidNode(name, "got", err)
+		}
+		selected, pointer, missing := selectPath(gotValue, steps)
+		if missing != "" {
+			return matchfmt.Node{
+				Name:     name,
+				Expected: fmt.Sprintf("value at %s", path),
+				Actual:   fmt.Sprintf("at JSON pointer %s: %s", pointerString(pointer), missing),
+			}
+		}
+		inner := match.Describe(plain(selected), matcher)
+		return matchfmt.Node{
+			Matched: inner.Matched,
+			Name:    name,
+			Details: []matchfmt.Detail{{
+				Label: fmt.Sprintf("%s (JSON pointer %s):", path, pointerString(pointer)),
+				Node:  &inner,
+			}},
+		}
+	})
+}
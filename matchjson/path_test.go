@@ -0,0 +1,122 @@
+package matchjson_test
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-match/matchjson"
+)
+
+const order = `{
+	"id": "A-1",
+	"items": [
+		{"name": "apple", "unit price": 0.5},
+		{"name": "pear", "unit price": 0.75}
+	]
+}`
+
+func TestJSONPath(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "root",
+			matcher: matchjson.JSONPath[string]("$", match.Not(match.Equal[any](nil))),
+			value:   order,
+			want:    true,
+		},
+		{
+			name:    "dotted_key",
+			matcher: matchjson.JSONPath[string]("$.id", match.Equal[any]("A-1")),
+			value:   order,
+			want:    true,
+		},
+		{
+			name:    "index_and_bracket_key",
+			matcher: matchjson.JSONPath[string]("$.items[1]['unit price']", match.Equal[any](0.75)),
+			value:   order,
+			want:    true,
+		},
+		{
+			name:    "value_does_not_match",
+			matcher: matchjson.JSONPath[string]("$.items[0].name", match.Equal[any]("pear")),
+			value:   order,
+			want:    false,
+		},
+		{
+			name:    "missing_key",
+			matcher: matchjson.JSONPath[string]("$.items[0].color", match.Equal[any]("red")),
+			value:   order,
+			want:    false,
+		},
+		{
+			name:    "index_out_of_range",
+			matcher: matchjson.JSONPath[string]("$.items[2]", match.Equal[any](nil)),
+			value:   order,
+			want:    false,
+		},
+		{
+			name:    "not_an_array",
+			matcher: matchjson.JSONPath[string]("$.id[0]", match.Equal[any](nil)),
+			value:   order,
+			want:    false,
+		},
+		{
+			name:    "invalid_path",
+			matcher: matchjson.JSONPath[string]("items[0]", match.Equal[any](nil)),
+			value:   order,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestJSONHasKeys(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]byte]
+		value   []byte
+		want    bool
+	}{
+		{
+			name:    "has_all_keys",
+			matcher: matchjson.JSONHasKeys[[]byte]("id", "items"),
+			value:   []byte(order),
+			want:    true,
+		},
+		{
+			name:    "missing_keys",
+			matcher: matchjson.JSONHasKeys[[]byte]("id", "total", "a/b"),
+			value:   []byte(order),
+			want:    false,
+		},
+		{
+			name:    "not_an_object",
+			matcher: matchjson.JSONHasKeys[[]byte]("id"),
+			value:   []byte(`[1, 2]`),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
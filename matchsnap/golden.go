@@ -0,0 +1,78 @@
+package matchsnap
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+// Data is the set of types that MatchesGolden accepts.
+type Data interface {
+	~string | ~[]byte
+}
+
+// compareGolden compares got against the golden file at path, or replaces
+// the file with got when updating.
+func (s *Snapshots) compareGolden(name, path string, got []byte) matchfmt.Node {
+	expected := fmt.Sprintf("got matches golden file %s", path)
+	if s.updating() {
+		if err := s.write(path, got); err != nil {
+			return matchfmt.Node{
+				Name:     name,
+				Expected: expected,
+				Actual:   fmt.Sprintf("could not update golden file: %v", err),
+			}
+		}
+		return matchfmt.Node{
+			Matched:  true,
+			Name:     name,
+			Expected: fmt.Sprintf("updated golden file %s", path),
+		}
+	}
+	want, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return matchfmt.Node{
+			Name:     name,
+			Expected: expected,
+			Actual:   "golden file does not exist; run with -update to create it",
+		}
+	}
+	if err != nil {
+		return matchfmt.Node{
+			Name:     name,
+			Expected: expected,
+			Actual:   fmt.Sprintf("could not read golden file: %v", err),
+		}
+	}
+	if string(want) == string(got) {
+		return matchfmt.Node{
+			Matched:  true,
+			Name:     name,
+			Expected: expected,
+		}
+	}
+	return matchfmt.Node{
+		Name:     name,
+		Expected: expected,
+		Actual:   "got differs from golden file; run with -update to accept got",
+		Details: []matchfmt.Detail{{
+			Label: "diff:",
+			Text:  matchfmt.UnifiedDiff(string(want), string(got), s.context),
+		}},
+	}
+}
+
+// MatchesGolden matches values equal to the contents of the golden file for
+// name.  On a mismatch the explanation includes a unified diff from the
+// golden file to got.
+func MatchesGolden[T Data](s *Snapshots, name string) match.Matcher[T] {
+	path := s.Path(name)
+	return match.MatcherFunc[T](func(got T) (bool, string) {
+		node := s.compareGolden("matchsnap.MatchesGolden", path, []byte(got))
+		return node.Matched, node.String()
+	})
+}
@@ -0,0 +1,146 @@
+package matchsnap_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/krelinga/go-match/matchsnap"
+	"github.com/sebdah/goldie/v2"
+)
+
+func TestMatchesGolden(t *testing.T) {
+	s := matchsnap.New(t, matchsnap.WithUpdate(false))
+	tests := []struct {
+		name      string
+		golden    string
+		got       string
+		wantMatch bool
+		want      string
+	}{
+		{
+			name:      "equal",
+			golden:    "greeting",
+			got:       "hello\nworld\n",
+			wantMatch: true,
+			want: `✅ matchsnap.MatchesGolden:
+   got matches golden file testdata/TestMatchesGolden/greeting.golden`,
+		},
+		{
+			name:   "different",
+			golden: "greeting",
+			got:    "hello\nthere\n",
+			want: `❌ matchsnap.MatchesGolden:
+   Expected: got matches golden file testdata/TestMatchesGolden/greeting.golden
+   Actual:   got differs from golden file; run with -update to accept got
+   diff:
+      --- want
+      +++ got
+      @@ -1,2 +1,2 @@
+       hello
+      -world
+      +there`,
+		},
+		{
+			name:   "missing",
+			golden: "missing",
+			got:    "anything",
+			want: `❌ matchsnap.MatchesGolden:
+   Expected: got matches golden file testdata/TestMatchesGolden/missing.golden
+   Actual:   golden file does not exist; run with -update to create it`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, explanation := matchsnap.MatchesGolden[string](s, tt.golden).Match(tt.got)
+			if matched != tt.wantMatch {
+				t.Errorf("Match() = %v, want %v", matched, tt.wantMatch)
+			}
+			if explanation != tt.want {
+				t.Errorf("explanation =\n%s\nwant\n%s", explanation, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesGoldenBytes(t *testing.T) {
+	s := matchsnap.New(t, matchsnap.WithTestNameForDir(false), matchsnap.WithDir("testdata/TestMatchesGolden"), matchsnap.WithUpdate(false))
+	if matched, explanation := matchsnap.MatchesGolden[[]byte](s, "greeting").Match([]byte("hello\nworld\n")); !matched {
+		t.Errorf("Match() = false\n%s", explanation)
+	}
+}
+
+func TestMatchesGoldenUpdate(t *testing.T) {
+	dir := t.TempDir()
+	update := matchsnap.New(t, matchsnap.WithDir(dir), matchsnap.WithSuffix(".txt"), matchsnap.WithUpdate(true))
+	path := filepath.Join(dir, "TestMatchesGoldenUpdate", "out.txt")
+	if got := update.Path("out"); got != path {
+		t.Errorf("Path() = %s, want %s", got, path)
+	}
+
+	matched, explanation := matchsnap.MatchesGolden[string](update, "out").Match("new\ncontents\n")
+	if !matched {
+		t.Errorf("Match() while updating = false\n%s", explanation)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	if string(data) != "new\ncontents\n" {
+		t.Errorf("golden file = %q", data)
+	}
+
+	compare := matchsnap.New(t, matchsnap.WithDir(dir), matchsnap.WithSuffix(".txt"), matchsnap.WithUpdate(false))
+	if matched, explanation := matchsnap.MatchesGolden[string](compare, "out").Match("new\ncontents\n"); !matched {
+		t.Errorf("Match() after update = false\n%s", explanation)
+	}
+	if matched, _ := matchsnap.MatchesGolden[string](compare, "out").Match("old\ncontents\n"); matched {
+		t.Error("Match() of different contents after update = true")
+	}
+}
+
+func TestUpdateMode(t *testing.T) {
+	// goldie defines -update when it is initialized, so linking it checks
+	// that matchsnap shares the flag instead of redefining it.
+	_ = goldie.New(t)
+	matchsnap.RegisterUpdateFlag()
+	updateFlag := flag.Lookup("update")
+	if updateFlag == nil {
+		t.Fatal("-update is not defined")
+	}
+
+	dir := t.TempDir()
+	s := matchsnap.New(t, matchsnap.WithDir(dir))
+	path := s.Path("out")
+	write := func(t *testing.T, got string) {
+		t.Helper()
+		if matched, explanation := matchsnap.MatchesGolden[string](s, "out").Match(got); !matched {
+			t.Fatalf("Match() = false\n%s", explanation)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("ReadFile() error: %v", err)
+		}
+		if string(data) != got {
+			t.Errorf("golden file = %q, want %q", data, got)
+		}
+	}
+
+	t.Run("flag", func(t *testing.T) {
+		if err := updateFlag.Value.Set("true"); err != nil {
+			t.Fatal(err)
+		}
+		defer updateFlag.Value.Set("false")
+		write(t, "from flag\n")
+	})
+	t.Run("environment", func(t *testing.T) {
+		t.Setenv("MATCHSNAP_UPDATE", "1")
+		write(t, "from environment\n")
+	})
+	t.Run("off", func(t *testing.T) {
+		if matched, _ := matchsnap.MatchesGolden[string](s, "out").Match("not written\n"); matched {
+			t.Error("Match() = true outside update mode")
+		}
+	})
+}
@@ -0,0 +1,271 @@
+package matchsnap
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+// WithSerializer registers f to render values of type T in snapshots,
+// replacing the built-in rendering.  f's result is inserted into the snapshot
+// as-is, so it should identify the type, for example
+//
+//	matchsnap.WithSerializer(func(id uuid.UUID) string {
+//		return "uuid.UUID(" + strconv.Quote(id.String()) + ")"
+//	})
+//
+// Serializers apply only to values whose dynamic type is exactly T.  time.Time
+// and time.Duration have serializers by default.
+func WithSerializer[T any](f func(T) string) Option {
+	return func(s *Snapshots) {
+		s.serializers[reflect.TypeFor[T]()] = func(v reflect.Value) string {
+			return f(v.Interface().(T))
+		}
+	}
+}
+
+func defaultSerializers() map[reflect.Type]func(reflect.Value) string {
+	return map[reflect.Type]func(reflect.Value) string{
+		reflect.TypeFor[time.Time](): func(v reflect.Value) string {
+			return "time.Time(" + strconv.Quote(v.Interface().(time.Time).Format(time.RFC3339Nano)) + ")"
+		},
+		reflect.TypeFor[time.Duration](): func(v reflect.Value) string {
+			return "time.Duration(" + strconv.Quote(v.Interface().(time.Duration).String()) + ")"
+		},
+	}
+}
+
+// refKey identifies a pointer, map or slice for detecting shared
+// references.  Slices are identified by their data pointer and length, so
+// that slices sharing a backing array but covering different elements are
+// distinct.
+type refKey struct {
+	typ reflect.Type
+	ptr uintptr
+	len int
+}
+
+// serializer renders values in a Go-like syntax that does not depend on
+// memory addresses or map iteration order.  Pointers, maps and slices that
+// are reached more than once are labelled "<ref N>" in the order they are first
+// rendered, which also makes cycles finite.
+type serializer struct {
+	funcs map[reflect.Type]func(reflect.Value) string
+	// counting is set during the first pass, which only fills in visits.
+	counting bool
+	visits   map[refKey]int
+	ids      map[refKey]int
+}
+
+func serialize(funcs map[reflect.Type]func(reflect.Value) string, v reflect.Value) string {
+	s := &serializer{funcs: funcs, counting: true, visits: map[refKey]int{}, ids: map[refKey]int{}}
+	s.render(v, true)
+	s.counting = false
+	return s.render(v, true)
+}
+
+func typeName(t reflect.Type) string {
+	return strings.ReplaceAll(t.String(), "interface {}", "any")
+}
+
+// untypedScalar reports whether constants of t's kind default to t, so that
+// a value of type t needs no conversion to be read back with its type.
+func untypedScalar(t reflect.Type) bool {
+	switch t {
+	case reflect.TypeFor[bool](), reflect.TypeFor[int](), reflect.TypeFor[float64](),
+		reflect.TypeFor[complex128](), reflect.TypeFor[string]():
+		return true
+	}
+	return false
+}
+
+// exported returns v with the read-only flag that reflect sets on values
+// reached through unexported fields removed, so that serializers can be
+// applied to them.  This is only possible for addressable values.
+func exported(v reflect.Value) (reflect.Value, bool) {
+	if v.CanInterface() {
+		return v, true
+	}
+	if !v.CanAddr() {
+		return v, false
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem(), true
+}
+
+// addressable returns an addressable copy of v, if v can be copied, so that
+// exported can be applied to the unexported fields within it.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() || !v.CanInterface() {
+		return v
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+func composite(name string, items []string) string {
+	if len(items) == 0 {
+		return name + "{}"
+	}
+	return name + "{\n" + matchfmt.Indent(strings.Join(items, "\n")) + "\n}"
+}
+
+// render renders v.  typed is set when the surrounding syntax does not
+// determine v's type, as at the top level, inside interfaces and behind
+// pointers; scalars then show their type unless it is the default type of
+// their kind.
+func (s *serializer) render(v reflect.Value, typed bool) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	if f, ok := s.funcs[v.Type()]; ok {
+		if ev, ok := exported(v); ok {
+			if s.counting {
+				return ""
+			}
+			return f(ev)
+		}
+	}
+
+	var lit string
+	switch v.Kind() {
+	case reflect.Bool:
+		lit = strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		lit = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		lit = strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		lit = strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+		if !strings.ContainsAny(lit, ".eIN") {
+			lit += ".0"
+		}
+	case reflect.Complex64, reflect.Complex128:
+		lit = strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits())
+	case reflect.String:
+		lit = strconv.Quote(v.String())
+	case reflect.Interface:
+		if v.IsNil() {
+			return "nil"
+		}
+		return s.render(addressable(v.Elem()), true)
+	case reflect.Pointer:
+		if v.IsNil() {
+			return "(" + typeName(v.Type()) + ")(nil)"
+		}
+		return s.ref(v, func() string { return "&" + s.render(v.Elem(), true) })
+	case reflect.Map:
+		if v.IsNil() {
+			return typeName(v.Type()) + "(nil)"
+		}
+		return s.ref(v, func() string { return s.renderMap(v) })
+	case reflect.Struct:
+		items := make([]string, 0, v.NumField())
+		for i := range v.NumField() {
+			items = append(items, v.Type().Field(i).Name+": "+s.render(v.Field(i), false)+",")
+		}
+		return composite(typeName(v.Type()), items)
+	case reflect.Slice:
+		if v.IsNil() {
+			return typeName(v.Type()) + "(nil)"
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return typeName(v.Type()) + "(" + strconv.Quote(string(v.Bytes())) + ")"
+		}
+		if v.Len() == 0 {
+			return typeName(v.Type()) + "{}"
+		}
+		return s.ref(v, func() string { return composite(typeName(v.Type()), s.renderElems(v)) })
+	case reflect.Array:
+		return composite(typeName(v.Type()), s.renderElems(v))
+	default:
+		// Channels, functions and unsafe pointers have nothing to show but
+		// their address, which is not stable.
+		if v.IsNil() {
+			return "(" + typeName(v.Type()) + ")(nil)"
+		}
+		return "(" + typeName(v.Type()) + ")(<non-nil>)"
+	}
+	if typed && !untypedScalar(v.Type()) {
+		return typeName(v.Type()) + "(" + lit + ")"
+	}
+	return lit
+}
+
+// ref renders a pointer, map or non-empty slice with body, or as a reference to its earlier
+// rendering.
+func (s *serializer) ref(v reflect.Value, body func() string) string {
+	key := refKey{typ: v.Type(), ptr: v.Pointer()}
+	if v.Kind() == reflect.Slice {
+		key.len = v.Len()
+	}
+	if s.counting {
+		s.visits[key]++
+		if s.visits[key] > 1 {
+			return ""
+		}
+		return body()
+	}
+	if s.visits[key] < 2 {
+		return body()
+	}
+	if id, ok := s.ids[key]; ok {
+		return "<ref " + strconv.Itoa(id) + ">"
+	}
+	id := len(s.ids) + 1
+	s.ids[key] = id
+	return "<ref " + strconv.Itoa(id) + "> " + body()
+}
+
+func (s *serializer) renderElems(v reflect.Value) []string {
+	items := make([]string, 0, v.Len())
+	for i := range v.Len() {
+		items = append(items, s.render(v.Index(i), false)+",")
+	}
+	return items
+}
+
+// compareKeys orders map keys numerically if they are numbers and by their
+// rendering otherwise.
+func compareKeys(a, b reflect.Value, aText, bText string) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		if c := cmp.Compare(a.Float(), b.Float()); c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(aText, bText)
+}
+
+// renderMap renders entries sorted by key.  Values are rendered in that
+// order so that reference labels do not depend on map iteration order.
+func (s *serializer) renderMap(v reflect.Value) string {
+	type entry struct {
+		key     reflect.Value
+		keyText string
+		value   reflect.Value
+	}
+	entries := make([]entry, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		entries = append(entries, entry{iter.Key(), s.render(iter.Key(), false), iter.Value()})
+	}
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		return compareKeys(a.key, b.key, a.keyText, b.keyText)
+	})
+	items := make([]string, len(entries))
+	for i, e := range entries {
+		items[i] = e.keyText + ": " + s.render(e.value, false) + ","
+	}
+	return composite(typeName(v.Type()), items)
+}
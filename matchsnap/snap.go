@@ -0,0 +1,150 @@
+// Package matchsnap provides golden-file matchers, which compare a value
+// against a file stored alongside the tests.
+//
+// MatchesGolden compares text or bytes.  MatchesSnapshot compares any value
+// by a readable rendering of it, in which map keys are sorted and pointers
+// are shown by what they point to rather than by address.
+//
+// Golden files live in testdata/<TestName>/<name>.golden by default.  In
+// update mode the matchers write got to the golden file and match instead of
+// comparing, which creates or refreshes every golden file.  Update mode is on
+// when the MATCHSNAP_UPDATE environment variable is true:
+//
+//	MATCHSNAP_UPDATE=1 go test ./...
+//
+// or when an -update flag is defined and set.  matchsnap does not define the
+// flag itself, so that it can share -update with goldie and other golden-file
+// libraries; a test package that links none of them can define it with
+// RegisterUpdateFlag.  WithUpdate overrides both.
+package matchsnap
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// RegisterUpdateFlag defines the -update flag if no package has defined it
+// yet.  Call it from a package-level variable in the test package, which is
+// initialized after every package it imports:
+//
+//	var _ = matchsnap.RegisterUpdateFlag()
+func RegisterUpdateFlag() bool {
+	if flag.Lookup("update") == nil {
+		flag.Bool("update", false, "update golden files instead of comparing against them")
+	}
+	return true
+}
+
+// updateMode reports whether the -update flag is set or MATCHSNAP_UPDATE is
+// true.
+func updateMode() bool {
+	if update, err := strconv.ParseBool(os.Getenv("MATCHSNAP_UPDATE")); err == nil && update {
+		return true
+	}
+	f := flag.Lookup("update")
+	if f == nil {
+		return false
+	}
+	getter, ok := f.Value.(flag.Getter)
+	if !ok {
+		return false
+	}
+	update, _ := getter.Get().(bool)
+	return update
+}
+
+// Snapshots locates the golden files of one test.
+type Snapshots struct {
+	t              testing.TB
+	dir            string
+	suffix         string
+	testNameForDir bool
+	context        int
+	update         *bool
+	serializers    map[reflect.Type]func(reflect.Value) string
+}
+
+type Option func(*Snapshots)
+
+// WithDir sets the directory that holds golden files.  The default is
+// "testdata".
+func WithDir(dir string) Option {
+	return func(s *Snapshots) {
+		s.dir = dir
+	}
+}
+
+// WithTestNameForDir controls whether golden files are placed in a
+// subdirectory named after the test.  The default is true.
+func WithTestNameForDir(use bool) Option {
+	return func(s *Snapshots) {
+		s.testNameForDir = use
+	}
+}
+
+// WithSuffix sets the file name suffix of golden files.  The default is
+// ".golden".
+func WithSuffix(suffix string) Option {
+	return func(s *Snapshots) {
+		s.suffix = suffix
+	}
+}
+
+// WithDiffContext sets the number of unchanged lines shown around each
+// change in a diff.  The default is 3.
+func WithDiffContext(lines int) Option {
+	return func(s *Snapshots) {
+		s.context = lines
+	}
+}
+
+// WithUpdate turns update mode on or off regardless of the -update flag
+// and MATCHSNAP_UPDATE.
+func WithUpdate(update bool) Option {
+	return func(s *Snapshots) {
+		s.update = &update
+	}
+}
+
+// New returns the Snapshots for t, whose name selects the golden file
+// directory.
+func New(t testing.TB, opts ...Option) *Snapshots {
+	s := &Snapshots{
+		t:              t,
+		dir:            "testdata",
+		suffix:         ".golden",
+		testNameForDir: true,
+		context:        3,
+		serializers:    defaultSerializers(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Path returns the golden file for name.
+func (s *Snapshots) Path(name string) string {
+	if s.testNameForDir {
+		return filepath.Join(s.dir, filepath.FromSlash(s.t.Name()), name+s.suffix)
+	}
+	return filepath.Join(s.dir, name+s.suffix)
+}
+
+func (s *Snapshots) updating() bool {
+	if s.update != nil {
+		return *s.update
+	}
+	return updateMode()
+}
+
+func (s *Snapshots) write(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,23 @@
+package matchsnap
+
+import (
+	"reflect"
+
+	"github.com/krelinga/go-match"
+)
+
+// MatchesSnapshot matches values whose rendering equals the golden file for
+// name.  Values are rendered in a Go-like syntax, one field or element per
+// line, with map entries sorted by key and pointers shown as "&" followed by
+// what they point to.  A pointer, map or slice reached more than once is
+// labelled "<ref N>" where it is first shown and rendered as just "<ref N>"
+// after that, so cycles are finite.  Use WithSerializer to control how
+// particular types are rendered.
+func MatchesSnapshot[T any](s *Snapshots, name string) match.Matcher[T] {
+	path := s.Path(name)
+	return match.MatcherFunc[T](func(got T) (bool, string) {
+		text := serialize(s.serializers, reflect.ValueOf(&got).Elem()) + "\n"
+		node := s.compareGolden("matchsnap.MatchesSnapshot", path, []byte(text))
+		return node.Matched, node.String()
+	})
+}
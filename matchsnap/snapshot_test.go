@@ -0,0 +1,152 @@
+package matchsnap_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-match/matchsnap"
+)
+
+type address struct {
+	City string
+	Zip  *int
+}
+
+type person struct {
+	Name     string
+	Age      uint8
+	Tags     []string
+	Scores   map[string]float64
+	Home     *address
+	Work     *address
+	Extra    any
+	Born     time.Time
+	Timeout  time.Duration
+	Raw      []byte
+	Callback func()
+	nickname string
+}
+
+type node struct {
+	Value int
+	Next  *node
+}
+
+type id struct {
+	hi, lo uint32
+}
+
+type record struct {
+	ID      id
+	Parents []id
+	secret  id
+}
+
+func TestMatchesSnapshot(t *testing.T) {
+	zip := 12345
+	home := &address{City: "Springfield", Zip: &zip}
+	ring := &node{Value: 1}
+	ring.Next = &node{Value: 2, Next: ring}
+	self := map[string]any{"name": "self"}
+	self["self"] = self
+	loop := []any{nil, "tail"}
+	loop[0] = loop
+	shared := []int{1, 2}
+
+	s := matchsnap.New(t, matchsnap.WithSerializer(func(v id) string {
+		return "id(" + strconv.Quote(strconv.FormatUint(uint64(v.hi)<<32|uint64(v.lo), 16)) + ")"
+	}))
+	tests := []struct {
+		name string
+		got  any
+	}{
+		{
+			name: "struct",
+			got: person{
+				Name:     "Homer",
+				Age:      39,
+				Tags:     []string{"dad", "safety inspector"},
+				Scores:   map[string]float64{"donuts": 9.5, "bowling": 7, "work": 0.25},
+				Home:     home,
+				Work:     home,
+				Extra:    []any{int64(1), "two", 3.0, nil, map[int]bool{2: true, 1: false}},
+				Born:     time.Date(1956, time.May, 12, 8, 30, 0, 0, time.UTC),
+				Timeout:  90 * time.Second,
+				Raw:      []byte("bytes\x00"),
+				Callback: func() {},
+				nickname: "Homie",
+			},
+		},
+		{
+			name: "zero_struct",
+			got:  person{},
+		},
+		{
+			name: "cycle",
+			got:  ring,
+		},
+		{
+			name: "map_cycle",
+			got:  self,
+		},
+		{
+			name: "numeric_keys",
+			got:  map[int]string{10: "ten", 9: "nine", -1: "minus one"},
+		},
+		{
+			name: "slice_cycle",
+			got:  loop,
+		},
+		{
+			name: "shared_slice",
+			got:  [][]int{shared, shared, shared[:1], {}},
+		},
+		{
+			name: "scalar",
+			got:  int32(-7),
+		},
+		{
+			name: "custom_serializer",
+			got: record{
+				ID:      id{hi: 1, lo: 0xff},
+				Parents: []id{{lo: 1}, {lo: 2}},
+				secret:  id{hi: 0xabc},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 3 {
+				if matched, explanation := matchsnap.MatchesSnapshot[any](s, tt.name).Match(tt.got); !matched {
+					t.Fatal(explanation)
+				}
+			}
+		})
+	}
+}
+
+func TestMatchesSnapshotMismatch(t *testing.T) {
+	s := matchsnap.New(t, matchsnap.WithUpdate(false))
+	got := map[string]int{"b": 2, "a": 1, "c": 4}
+	matched, explanation := matchsnap.MatchesSnapshot[map[string]int](s, "counts").Match(got)
+	if matched {
+		t.Fatal("Match() = true, want false")
+	}
+	want := `❌ matchsnap.MatchesSnapshot:
+   Expected: got matches golden file testdata/TestMatchesSnapshotMismatch/counts.golden
+   Actual:   got differs from golden file; run with -update to accept got
+   diff:
+      --- want
+      +++ got
+      @@ -1,5 +1,5 @@
+       map[string]int{
+          "a": 1,
+          "b": 2,
+      -   "c": 3,
+      +   "c": 4,
+       }`
+	if explanation != want {
+		t.Errorf("explanation =\n%s\nwant\n%s", explanation, want)
+	}
+}
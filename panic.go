@@ -0,0 +1,115 @@
+package match
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+type panicInfo struct {
+	panicked bool
+	value    any
+	frame    string
+}
+
+// panicFrame describes the frame that panicked.  It must be called from the
+// deferred function that recovers, where the stack still holds the frames
+// below runtime.gopanic.
+func panicFrame() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(0, pcs)])
+	inPanic := false
+	for {
+		frame, more := frames.Next()
+		if frame.Function == "runtime.gopanic" {
+			inPanic = true
+		} else if inPanic && !strings.HasPrefix(frame.Function, "runtime.") {
+			return fmt.Sprintf("%s (%s:%d)", frame.Function, filepath.Base(frame.File), frame.Line)
+		}
+		if !more {
+			return "unknown frame"
+		}
+	}
+}
+
+func callCatchingPanic(f func()) (info panicInfo) {
+	defer func() {
+		if info.panicked {
+			info.value = recover()
+			info.frame = panicFrame()
+		}
+	}()
+	info.panicked = true
+	f()
+	info.panicked = false
+	return info
+}
+
+func panicDetail(info panicInfo) matchfmt.Detail {
+	return matchfmt.Detail{Text: fmt.Sprintf("panicked at %s", info.frame)}
+}
+
+func Panics(matcher Matcher[any]) Matcher[func()] {
+	return onceMatcher(func(got func()) matchfmt.Node {
+		node := matchfmt.Node{Name: "match.Panics"}
+		info := callCatchingPanic(got)
+		if !info.panicked {
+			node.Expected = "got panics"
+			node.Actual = "got returned normally"
+			return node
+		}
+		inner := Describe(info.value, matcher)
+		node.Matched = inner.Matched
+		node.Details = []matchfmt.Detail{
+			panicDetail(info),
+			{Label: fmt.Sprintf("recovered %s:", DefaultString(info.value)), Node: &inner},
+		}
+		return node
+	})
+}
+
+func PanicsWithError(matcher Matcher[error]) Matcher[func()] {
+	return onceMatcher(func(got func()) matchfmt.Node {
+		node := matchfmt.Node{Name: "match.PanicsWithError"}
+		info := callCatchingPanic(got)
+		if !info.panicked {
+			node.Expected = "got panics with an error"
+			node.Actual = "got returned normally"
+			return node
+		}
+		err, ok := info.value.(error)
+		if !ok {
+			node.Expected = "got panics with an error"
+			node.Actual = fmt.Sprintf("got panicked with %s", DefaultString(info.value))
+			node.Details = []matchfmt.Detail{panicDetail(info)}
+			return node
+		}
+		inner := Describe(err, matcher)
+		node.Matched = inner.Matched
+		node.Details = []matchfmt.Detail{
+			panicDetail(info),
+			{Label: fmt.Sprintf("recovered %s:", describeError(err)), Node: &inner},
+		}
+		return node
+	})
+}
+
+func DoesNotPanic() Matcher[func()] {
+	return onceMatcher(func(got func()) matchfmt.Node {
+		node := matchfmt.Node{
+			Name:     "match.DoesNotPanic",
+			Expected: "got returns normally",
+		}
+		info := callCatchingPanic(got)
+		if !info.panicked {
+			node.Matched = true
+			return node
+		}
+		node.Actual = fmt.Sprintf("got panicked with %s", DefaultString(info.value))
+		node.Details = []matchfmt.Detail{panicDetail(info)}
+		return node
+	})
+}
@@ -0,0 +1,161 @@
+package match_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/krelinga/go-match"
+)
+
+func panicWith(value any) func() {
+	return func() {
+		panic(value)
+	}
+}
+
+func returnNormally() {}
+
+var lineNumber = regexp.MustCompile(`\.go:\d+\)`)
+
+// withoutLines removes line numbers from the panic locations in explanation,
+// so that goldens do not change whenever this file is edited.
+func withoutLines(explanation string) []byte {
+	return []byte(lineNumber.ReplaceAllString(explanation, ".go:<line>)"))
+}
+
+func TestPanics(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[func()]
+		value   func()
+		want    bool
+	}{
+		{
+			name:    "panics_with_matching_value",
+			matcher: match.Panics(match.Equal[any]("boom")),
+			value:   panicWith("boom"),
+			want:    true,
+		},
+		{
+			name:    "panics_with_other_value",
+			matcher: match.Panics(match.Equal[any]("boom")),
+			value:   panicWith(42),
+			want:    false,
+		},
+		{
+			name:    "does_not_panic",
+			matcher: match.Panics(match.Equal[any]("boom")),
+			value:   returnNormally,
+			want:    false,
+		},
+		{
+			name:    "runtime_error",
+			matcher: match.Panics(match.Alway[any]()),
+			value: func() {
+				var m map[string]int
+				m["x"] = 1
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, withoutLines(gotExplanation))
+		})
+	}
+}
+
+func TestPanicsWithError(t *testing.T) {
+	goldie := newGoldie(t)
+	errBoom := errors.New("boom")
+	tests := []struct {
+		name    string
+		matcher match.Matcher[func()]
+		value   func()
+		want    bool
+	}{
+		{
+			name:    "matching_error",
+			matcher: match.PanicsWithError(match.ErrorIs(errBoom)),
+			value:   panicWith(errBoom),
+			want:    true,
+		},
+		{
+			name:    "other_error",
+			matcher: match.PanicsWithError(match.ErrorIs(errBoom)),
+			value:   panicWith(errors.New("other")),
+			want:    false,
+		},
+		{
+			name:    "non_error_value",
+			matcher: match.PanicsWithError(match.ErrorIs(errBoom)),
+			value:   panicWith("boom"),
+			want:    false,
+		},
+		{
+			name:    "does_not_panic",
+			matcher: match.PanicsWithError(match.ErrorIs(errBoom)),
+			value:   returnNormally,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, withoutLines(gotExplanation))
+		})
+	}
+}
+
+func TestDoesNotPanic(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[func()]
+		value   func()
+		want    bool
+	}{
+		{
+			name:    "returns_normally",
+			matcher: match.DoesNotPanic(),
+			value:   returnNormally,
+			want:    true,
+		},
+		{
+			name:    "panics",
+			matcher: match.DoesNotPanic(),
+			value:   panicWith("boom"),
+			want:    false,
+		},
+		{
+			name:    "negated",
+			matcher: match.Not(match.DoesNotPanic()),
+			value:   panicWith("boom"),
+			want:    true,
+		},
+		{
+			name:    "composed",
+			matcher: match.AllOf(match.Panics(match.Equal[any]("boom")), match.Not(match.DoesNotPanic())),
+			value:   panicWith("boom"),
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, withoutLines(gotExplanation))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/krelinga/go-match/matchfmt"
+	"github.com/krelinga/go-typemap"
+)
+
+func pointerToImpl[T any](tm interface {
+	typemap.IsNil[*T]
+	typemap.Deref[T]
+	typemap.String[*T]
+}, name string, matcher Matcher[T]) Matcher[*T] {
+	return nodeMatcher[*T]{
+		impure: !sideEffectFree(matcher),
+		matches: func(got *T) bool {
+			return !tm.IsNil(got) && Matches(tm.Deref(got), matcher)
+		},
+		describe: func(got *T) matchfmt.Node {
+			if tm.IsNil(got) {
+				return matchfmt.Node{
+					Name:     name,
+					Expected: "got != nil",
+					Actual:   fmt.Sprintf("got == %s", tm.String(got)),
+				}
+			}
+			inner := Describe(tm.Deref(got), matcher)
+			return matchfmt.Node{
+				Matched: inner.Matched,
+				Name:    name,
+				Details: []matchfmt.Detail{{Node: &inner}},
+			}
+		},
+	}
+}
+
+func PointerToTm[T any](tm interface {
+	typemap.IsNil[*T]
+	typemap.Deref[T]
+	typemap.String[*T]
+}, matcher Matcher[T]) Matcher[*T] {
+	return pointerToImpl(tm, "match.PointerToTm", matcher)
+}
+
+func PointerTo[T any](matcher Matcher[T]) Matcher[*T] {
+	tm := typemap.ForPointer[T]{
+		StringFunc: DefaultPtrString[T],
+	}
+	return pointerToImpl(tm, "match.PointerTo", matcher)
+}
@@ -0,0 +1,90 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-typemap"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestPointerToTm(t *testing.T) {
+	goldie := newGoldie(t)
+	tm := typemap.ForPointer[int]{
+		StringFunc: func(p *int) string {
+			if p == nil {
+				return "<nil>"
+			}
+			return "<ptr>"
+		},
+	}
+	tests := []struct {
+		name    string
+		matcher match.Matcher[*int]
+		value   *int
+		want    bool
+	}{
+		{
+			name:    "pointee_matches",
+			matcher: match.PointerToTm(tm, match.Equal(42)),
+			value:   ptr(42),
+			want:    true,
+		},
+		{
+			name:    "nil_pointer",
+			matcher: match.PointerToTm(tm, match.Equal(42)),
+			value:   nil,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestPointerTo(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[*string]
+		value   *string
+		want    bool
+	}{
+		{
+			name:    "pointee_matches",
+			matcher: match.PointerTo(match.Equal("hello")),
+			value:   ptr("hello"),
+			want:    true,
+		},
+		{
+			name:    "pointee_does_not_match",
+			matcher: match.PointerTo(match.Equal("hello")),
+			value:   ptr("world"),
+			want:    false,
+		},
+		{
+			name:    "nil_pointer",
+			matcher: match.PointerTo(match.Equal("hello")),
+			value:   nil,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
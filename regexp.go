@@ -0,0 +1,150 @@
+package match
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+
+	"github.com/krelinga/go-match/matchfmt"
+	"github.com/krelinga/go-typemap"
+)
+
+// invalidPatternNode reports a pattern that did not compile.  Matchers built
+// from an invalid pattern never match.
+func invalidPatternNode(name, pattern string, err error) matchfmt.Node {
+	return matchfmt.Node{
+		Name:     name,
+		Expected: fmt.Sprintf("valid regexp %q", pattern),
+		Actual:   err.Error(),
+	}
+}
+
+func stringRegexpImpl[T ~string](tm typemap.String[T], name, pattern, verb string, anchored bool) Matcher[T] {
+	re, err := regexp.Compile(pattern)
+	if err == nil && anchored {
+		re = regexp.MustCompile(`^(?:` + pattern + `)$`)
+	}
+	return nodeMatcher[T]{
+		matches: func(got T) bool {
+			return err == nil && re.MatchString(string(got))
+		},
+		describe: func(got T) matchfmt.Node {
+			if err != nil {
+				return invalidPatternNode(name, pattern, err)
+			}
+			node := matchfmt.Node{
+				Matched:  re.MatchString(string(got)),
+				Name:     name,
+				Expected: fmt.Sprintf("got %s /%s/", verb, pattern),
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("got == %s", tm.String(got))
+			}
+			return node
+		},
+	}
+}
+
+func StringLikeMatchesRegexpTm[T ~string](tm typemap.String[T], pattern string) Matcher[T] {
+	return stringRegexpImpl(tm, "match.StringLikeMatchesRegexpTm", pattern, "fully matches", true)
+}
+
+func StringLikeMatchesRegexp[T ~string](pattern string) Matcher[T] {
+	tm := typemap.ForStringLike[T]{
+		StringFunc: DefaultString[T],
+	}
+	return stringRegexpImpl(tm, "match.StringLikeMatchesRegexp", pattern, "fully matches", true)
+}
+
+func StringMatchesRegexp(pattern string) Matcher[string] {
+	tm := typemap.ForString{
+		StringFunc: DefaultString[string],
+	}
+	return stringRegexpImpl(tm, "match.StringMatchesRegexp", pattern, "fully matches", true)
+}
+
+func StringLikeFindsRegexpTm[T ~string](tm typemap.String[T], pattern string) Matcher[T] {
+	return stringRegexpImpl(tm, "match.StringLikeFindsRegexpTm", pattern, "contains a match for", false)
+}
+
+func StringLikeFindsRegexp[T ~string](pattern string) Matcher[T] {
+	tm := typemap.ForStringLike[T]{
+		StringFunc: DefaultString[T],
+	}
+	return stringRegexpImpl(tm, "match.StringLikeFindsRegexp", pattern, "contains a match for", false)
+}
+
+func StringFindsRegexp(pattern string) Matcher[string] {
+	tm := typemap.ForString{
+		StringFunc: DefaultString[string],
+	}
+	return stringRegexpImpl(tm, "match.StringFindsRegexp", pattern, "contains a match for", false)
+}
+
+func stringRegexpGroupsImpl[T ~string](tm typemap.String[T], name, pattern string, groups map[string]Matcher[string]) Matcher[T] {
+	re, err := regexp.Compile(pattern)
+	names := make([]string, 0, len(groups))
+	for group := range groups {
+		names = append(names, group)
+	}
+	slices.Sort(names)
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(slices.Collect(maps.Values(groups))...),
+		describe: func(got T) matchfmt.Node {
+			if err != nil {
+				return invalidPatternNode(name, pattern, err)
+			}
+			node := matchfmt.Node{Name: name}
+			submatches := re.FindStringSubmatchIndex(string(got))
+			if submatches == nil {
+				node.Expected = fmt.Sprintf("got contains a match for /%s/", pattern)
+				node.Actual = fmt.Sprintf("got == %s", tm.String(got))
+				return node
+			}
+			node.Matched = true
+			var failing []string
+			for _, group := range names {
+				detail := matchfmt.Detail{Label: fmt.Sprintf("group %q:", group)}
+				index := re.SubexpIndex(group)
+				switch {
+				case index == -1:
+					detail.Text = matchfmt.ActualVsExpected("pattern has no such group", fmt.Sprintf("capture group named %q", group))
+				case submatches[2*index] == -1:
+					detail.Text = matchfmt.ActualVsExpected("group did not participate in the match", fmt.Sprintf("group %q captured text", group))
+				default:
+					inner := Describe(string(got)[submatches[2*index]:submatches[2*index+1]], groups[group])
+					detail.Node = &inner
+				}
+				if detail.Node == nil || !detail.Node.Matched {
+					node.Matched = false
+					failing = append(failing, group)
+				}
+				node.Details = append(node.Details, detail)
+			}
+			if !node.Matched {
+				summary := matchfmt.Detail{Text: fmt.Sprintf("failing groups: %q", failing)}
+				node.Details = append([]matchfmt.Detail{summary}, node.Details...)
+			}
+			return node
+		},
+	}
+}
+
+func StringLikeRegexpGroupsTm[T ~string](tm typemap.String[T], pattern string, groups map[string]Matcher[string]) Matcher[T] {
+	return stringRegexpGroupsImpl(tm, "match.StringLikeRegexpGroupsTm", pattern, groups)
+}
+
+func StringLikeRegexpGroups[T ~string](pattern string, groups map[string]Matcher[string]) Matcher[T] {
+	tm := typemap.ForStringLike[T]{
+		StringFunc: DefaultString[T],
+	}
+	return stringRegexpGroupsImpl(tm, "match.StringLikeRegexpGroups", pattern, groups)
+}
+
+func StringRegexpGroups(pattern string, groups map[string]Matcher[string]) Matcher[string] {
+	tm := typemap.ForString{
+		StringFunc: DefaultString[string],
+	}
+	return stringRegexpGroupsImpl(tm, "match.StringRegexpGroups", pattern, groups)
+}
@@ -0,0 +1,224 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-typemap"
+)
+
+func TestStringMatchesRegexp(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "full_match",
+			matcher: match.StringMatchesRegexp(`[a-z]+-\d+`),
+			value:   "abc-123",
+			want:    true,
+		},
+		{
+			name:    "partial_match_is_not_enough",
+			matcher: match.StringMatchesRegexp(`[a-z]+-\d+`),
+			value:   "id abc-123",
+			want:    false,
+		},
+		{
+			name:    "alternation_is_anchored_as_a_whole",
+			matcher: match.StringMatchesRegexp(`a|b`),
+			value:   "ab",
+			want:    false,
+		},
+		{
+			name:    "invalid_pattern",
+			matcher: match.StringMatchesRegexp(`a(`),
+			value:   "a",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestStringFindsRegexp(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "found",
+			matcher: match.StringFindsRegexp(`\d+`),
+			value:   "order 42 shipped",
+			want:    true,
+		},
+		{
+			name:    "not_found",
+			matcher: match.StringFindsRegexp(`\d+`),
+			value:   "no digits",
+			want:    false,
+		},
+		{
+			name:    "invalid_pattern",
+			matcher: match.StringFindsRegexp(`[`),
+			value:   "x",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestStringRegexpGroups(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name: "groups_match",
+			matcher: match.StringRegexpGroups(`(?P<user>\w+)@(?P<host>[\w.]+)`, map[string]match.Matcher[string]{
+				"user": match.Equal("alice"),
+				"host": match.StringHasSuffix(".com"),
+			}),
+			value: "mail alice@example.com now",
+			want:  true,
+		},
+		{
+			name: "group_does_not_match",
+			matcher: match.StringRegexpGroups(`(?P<user>\w+)@(?P<host>[\w.]+)`, map[string]match.Matcher[string]{
+				"user": match.Equal("bob"),
+				"host": match.StringHasSuffix(".com"),
+			}),
+			value: "alice@example.com",
+			want:  false,
+		},
+		{
+			name: "no_match",
+			matcher: match.StringRegexpGroups(`(?P<user>\w+)@`, map[string]match.Matcher[string]{
+				"user": match.Equal("bob"),
+			}),
+			value: "no address",
+			want:  false,
+		},
+		{
+			name: "unknown_and_optional_groups",
+			matcher: match.StringRegexpGroups(`(?P<a>x)?y`, map[string]match.Matcher[string]{
+				"a": match.Equal("x"),
+				"b": match.Equal("y"),
+			}),
+			value: "y",
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestStringLikeRegexp(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[label]
+		value   label
+		want    bool
+	}{
+		{
+			name:    "matches",
+			matcher: match.StringLikeMatchesRegexp[label](`a.c`),
+			value:   "abc",
+			want:    true,
+		},
+		{
+			name:    "finds",
+			matcher: match.StringLikeFindsRegexp[label](`b`),
+			value:   "xyz",
+			want:    false,
+		},
+		{
+			name:    "groups",
+			matcher: match.StringLikeRegexpGroups[label](`(?P<n>\d+)`, map[string]match.Matcher[string]{"n": match.Equal("7")}),
+			value:   "v7",
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestStringLikeRegexpTm(t *testing.T) {
+	goldie := newGoldie(t)
+	tm := typemap.StringFunc[label](func(l label) string {
+		return "label(" + string(l) + ")"
+	})
+	tests := []struct {
+		name    string
+		matcher match.Matcher[label]
+		value   label
+		want    bool
+	}{
+		{
+			name:    "matches",
+			matcher: match.StringLikeMatchesRegexpTm(tm, `a.c`),
+			value:   "abd",
+			want:    false,
+		},
+		{
+			name:    "finds",
+			matcher: match.StringLikeFindsRegexpTm(tm, `b`),
+			value:   "xyz",
+			want:    false,
+		},
+		{
+			name:    "groups",
+			matcher: match.StringLikeRegexpGroupsTm(tm, `(?P<n>\d+)`, map[string]match.Matcher[string]{"n": match.Equal("8")}),
+			value:   "v7",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package match
+
+import "github.com/krelinga/go-match/matchfmt"
+
+// Predicate is implemented by matchers that can decide whether got matches
+// without rendering an explanation.  Matchers that implement it let callers
+// who only need the verdict skip all formatting work.
+type Predicate[T any] interface {
+	Matches(got T) bool
+}
+
+// Matches reports whether got matches, using the matcher's Predicate
+// implementation when it has one and falling back to Match otherwise.
+func Matches[T any](got T, matcher Matcher[T]) bool {
+	if p, ok := matcher.(Predicate[T]); ok {
+		return p.Matches(got)
+	}
+	matched, _ := matcher.Match(got)
+	return matched
+}
+
+// Describer is implemented by matchers that can report their explanation as
+// a structured matchfmt.Node rather than only as rendered text.
+type Describer[T any] interface {
+	Describe(got T) matchfmt.Node
+}
+
+// Describe returns the explanation tree for matching got, using the
+// matcher's Describer implementation when it has one.  Other matchers are
+// wrapped in a matchfmt.Raw node holding their explanation text.
+func Describe[T any](got T, matcher Matcher[T]) matchfmt.Node {
+	if d, ok := matcher.(Describer[T]); ok {
+		return d.Describe(got)
+	}
+	matched, explanation := matcher.Match(got)
+	return matchfmt.Raw(matched, explanation)
+}
+
+// Result holds the outcome of Check.  The explanation is only rendered when
+// Explanation is called, so a successful check that is never inspected does
+// no formatting.
+type Result[T any] struct {
+	matched     bool
+	rendered    bool
+	explanation string
+	matcher     Matcher[T]
+	got         T
+}
+
+// Check runs matcher against got.  The explanation is rendered lazily, by
+// running matcher again, only when every matcher in the tree is free of side
+// effects; otherwise matcher runs exactly once and its explanation is kept.
+func Check[T any](got T, matcher Matcher[T]) Result[T] {
+	if p, ok := matcher.(Predicate[T]); ok && sideEffectFree(matcher) {
+		return Result[T]{
+			matched: p.Matches(got),
+			matcher: matcher,
+			got:     got,
+		}
+	}
+	matched, explanation := matcher.Match(got)
+	return Result[T]{
+		matched:     matched,
+		rendered:    true,
+		explanation: explanation,
+		matcher:     matcher,
+		got:         got,
+	}
+}
+
+func (r Result[T]) Matched() bool {
+	return r.matched
+}
+
+func (r Result[T]) Explanation() string {
+	if r.rendered {
+		return r.explanation
+	}
+	_, explanation := r.matcher.Match(r.got)
+	return explanation
+}
+
+// Node returns the explanation as a structured tree.
+func (r Result[T]) Node() matchfmt.Node {
+	if r.rendered {
+		if _, ok := r.matcher.(Describer[T]); !ok || !sideEffectFree(r.matcher) {
+			return matchfmt.Raw(r.matched, r.explanation)
+		}
+	}
+	return Describe(r.got, r.matcher)
+}
+
+// nodeMatcher builds its explanation as a matchfmt.Node.  The optional
+// matches func is a cheap predicate used when only the verdict is needed;
+// without it, Matches falls back to describe.  Combinators set impure when
+// any inner matcher may have side effects, so that Check does not run the
+// tree a second time to render its explanation.
+type nodeMatcher[T any] struct {
+	matches  func(got T) bool
+	describe func(got T) matchfmt.Node
+	impure   bool
+}
+
+func (m nodeMatcher[T]) sideEffectFree() bool {
+	return !m.impure
+}
+
+// sideEffectFree reports whether running the matchers again is harmless.
+// Only matchers from this package can vouch for that; any other matcher is
+// assumed to have side effects.
+func sideEffectFree[T any](matchers ...Matcher[T]) bool {
+	for _, matcher := range matchers {
+		pure, ok := matcher.(interface{ sideEffectFree() bool })
+		if !ok || !pure.sideEffectFree() {
+			return false
+		}
+	}
+	return true
+}
+
+func (m nodeMatcher[T]) Matches(got T) bool {
+	if m.matches == nil {
+		return m.describe(got).Matched
+	}
+	return m.matches(got)
+}
+
+func (m nodeMatcher[T]) Describe(got T) matchfmt.Node {
+	return m.describe(got)
+}
+
+func (m nodeMatcher[T]) Match(got T) (bool, string) {
+	node := m.describe(got)
+	return node.Matched, node.String()
+}
+
+// onceMatcher is for matchers with side effects, such as receiving from a
+// channel or polling.  It renders its explanation in the same call that
+// decides the verdict, and it does not report itself as side-effect free, so
+// Check runs any tree that contains it exactly once.
+func onceMatcher[T any](describe func(got T) matchfmt.Node) Matcher[T] {
+	return MatcherFunc[T](func(got T) (bool, string) {
+		node := describe(got)
+		return node.Matched, node.String()
+	})
+}
@@ -0,0 +1,314 @@
+package match_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-match"
+)
+
+func TestCheck(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[int]
+		value   int
+		want    bool
+	}{
+		{
+			name:    "lazy_matched",
+			matcher: match.AllOf(match.GreaterThan(10), match.LessThan(100)),
+			value:   42,
+			want:    true,
+		},
+		{
+			name:    "lazy_not_matched",
+			matcher: match.AllOf(match.GreaterThan(10), match.LessThan(20)),
+			value:   42,
+			want:    false,
+		},
+		{
+			name: "eager_matcher_func",
+			matcher: match.MatcherFunc[int](func(got int) (bool, string) {
+				return got == 42, "custom explanation"
+			}),
+			value: 42,
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := match.Check(tt.value, tt.matcher)
+			if got := result.Matched(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if _, want := tt.matcher.Match(tt.value); result.Explanation() != want {
+				t.Errorf("Explanation() = %q, want %q", result.Explanation(), want)
+			}
+			goldie.Assert(t, tt.name, []byte(result.Explanation()))
+		})
+	}
+}
+
+func TestCheckRunsSideEffectsOnce(t *testing.T) {
+	newChan := func() chan int {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		return ch
+	}
+	matcher := match.AllOf(match.ChanReceives(10*time.Millisecond, match.Equal(5)))
+
+	ch := newChan()
+	result := match.Check[<-chan int](ch, matcher)
+	if result.Matched() {
+		t.Fatal("Check().Matched() = true, want false")
+	}
+	if explanation := result.Explanation(); !strings.Contains(explanation, "received 1:") {
+		t.Errorf("Check().Explanation() does not describe the received value 1:\n%s", explanation)
+	}
+	if node := result.Node(); node.String() != result.Explanation() {
+		t.Errorf("Check().Node().String() = %q, want %q", node.String(), result.Explanation())
+	}
+	if len(ch) != 2 {
+		t.Errorf("Check() received %d values, want 1", 3-len(ch))
+	}
+
+	ch = newChan()
+	tb := &fakeTB{}
+	match.Expect[<-chan int](tb, ch, match.Not(matcher))
+	match.Expect[<-chan int](tb, ch, matcher)
+	if len(tb.errors) != 1 || !strings.Contains(tb.errors[0], "received 2:") {
+		t.Errorf("Expect() errors = %q, want one describing the received value 2", tb.errors)
+	}
+	if len(ch) != 1 {
+		t.Errorf("Expect() received %d values, want 2", 3-len(ch))
+	}
+}
+
+func TestCheckRunsTransformOnce(t *testing.T) {
+	readAll := func(r io.Reader) string {
+		body, _ := io.ReadAll(r)
+		return string(body)
+	}
+	matcher := match.Transform("body", readAll, match.Equal("expected body"))
+
+	tb := &fakeTB{}
+	match.Expect[io.Reader](tb, strings.NewReader("actual body"), matcher)
+	if len(tb.errors) != 1 || !strings.Contains(tb.errors[0], `"actual body"`) {
+		t.Errorf("Expect() errors = %q, want one describing the body read", tb.errors)
+	}
+
+	readErr := func(r io.Reader) (string, error) {
+		return readAll(r), nil
+	}
+	result := match.Check[io.Reader](strings.NewReader("actual body"), match.TransformErr("body", readErr, match.Equal("expected body")))
+	if explanation := result.Explanation(); !strings.Contains(explanation, `"actual body"`) {
+		t.Errorf("Check().Explanation() does not describe the body read:\n%s", explanation)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+	}{
+		{
+			name:    "slice_length",
+			matcher: match.SliceLength[int](match.Equal(3)),
+			value:   []int{1, 2, 3},
+		},
+		{
+			name:    "slice_each_any_of",
+			matcher: match.SliceEach(match.AnyOf(match.Equal(1), match.GreaterThan(2))),
+			value:   []int{1, 2, 3},
+		},
+		{
+			name:    "not_slice_contains",
+			matcher: match.Not(match.SliceContains(match.Equal(4))),
+			value:   []int{1, 2, 3},
+		},
+		{
+			name: "eager_matcher_func",
+			matcher: match.MatcherFunc[[]int](func(got []int) (bool, string) {
+				return len(got) == 2, "custom explanation"
+			}),
+			value: []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, _ := tt.matcher.Match(tt.value)
+			if got := match.Matches(tt.value, tt.matcher); got != want {
+				t.Errorf("Matches() = %v, Match() = %v", got, want)
+			}
+		})
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		name        string
+		matcher     match.Matcher[[]int]
+		value       []int
+		wantMatched bool
+		wantLeaves  []string
+	}{
+		{
+			name:        "matched",
+			matcher:     match.SliceElementsAre(match.Equal(1), match.Equal(2)),
+			value:       []int{1, 2},
+			wantMatched: true,
+		},
+		{
+			name: "nested failures",
+			matcher: match.AllOf(
+				match.SliceElementsAre(match.Equal(1), match.AnyOf(match.Equal(5), match.Equal(6))),
+				match.SliceLength[int](match.LessThan(1)),
+			),
+			value:      []int{1, 2},
+			wantLeaves: []string{"match.Equal", "match.Equal", "match.LessThan"},
+		},
+		{
+			name: "raw matcher",
+			matcher: match.MatcherFunc[[]int](func([]int) (bool, string) {
+				return false, "custom"
+			}),
+			value:      []int{1},
+			wantLeaves: []string{""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := match.Describe(tt.value, tt.matcher)
+			if node.Matched != tt.wantMatched {
+				t.Errorf("Matched = %v, want %v", node.Matched, tt.wantMatched)
+			}
+			if _, explanation := tt.matcher.Match(tt.value); node.String() != explanation {
+				t.Errorf("String() = %q, want %q", node.String(), explanation)
+			}
+			if resultNode := match.Check(tt.value, tt.matcher).Node(); resultNode.String() != node.String() {
+				t.Errorf("Check().Node().String() = %q, want %q", resultNode.String(), node.String())
+			}
+			leaves := node.FailingLeaves()
+			if len(leaves) != len(tt.wantLeaves) {
+				t.Fatalf("got %d failing leaves, want %d", len(leaves), len(tt.wantLeaves))
+			}
+			for i, leaf := range leaves {
+				if leaf.Name != tt.wantLeaves[i] {
+					t.Errorf("leaf %d name = %q, want %q", i, leaf.Name, tt.wantLeaves[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMatchedPathDoesNotAllocate(t *testing.T) {
+	large := make([]int, 1000)
+	for i := range large {
+		large[i] = i
+	}
+	m := map[string]int{"a": 1}
+	equal := match.Equal(42)
+	allOf := match.AllOf(match.GreaterThan(10), match.LessThan(100), match.NotEqual(7))
+	stringLength := match.StringLength(match.Equal(5))
+	sliceLength := match.SliceLength[int](match.Equal(1000))
+	mapLength := match.MapLength[string, int](match.Equal(1))
+	sliceEach := match.SliceEach(match.AllOf(match.GreaterThanOrEqual(0), match.LessThan(1000)))
+	tests := []struct {
+		name  string
+		check func() bool
+	}{
+		{
+			name:  "equal",
+			check: func() bool { return match.Check(42, equal).Matched() },
+		},
+		{
+			name:  "all_of",
+			check: func() bool { return match.Check(42, allOf).Matched() },
+		},
+		{
+			name:  "string_length",
+			check: func() bool { return match.Check("hello", stringLength).Matched() },
+		},
+		{
+			name:  "slice_length",
+			check: func() bool { return match.Check(large, sliceLength).Matched() },
+		},
+		{
+			name:  "map_length",
+			check: func() bool { return match.Check(m, mapLength).Matched() },
+		},
+		{
+			name:  "slice_each_all_of",
+			check: func() bool { return match.Check(large, sliceEach).Matched() },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.check() {
+				t.Fatal("expected match")
+			}
+			allocs := testing.AllocsPerRun(100, func() {
+				tt.check()
+			})
+			if allocs != 0 {
+				t.Errorf("got %v allocations per run, want 0", allocs)
+			}
+		})
+	}
+}
+
+func BenchmarkCheckEqual(b *testing.B) {
+	matcher := match.Equal(42)
+	b.ReportAllocs()
+	for b.Loop() {
+		match.Check(42, matcher)
+	}
+}
+
+func BenchmarkCheckAllOf(b *testing.B) {
+	matcher := match.AllOf(match.GreaterThan(10), match.LessThan(100), match.NotEqual(7))
+	b.ReportAllocs()
+	for b.Loop() {
+		match.Check(42, matcher)
+	}
+}
+
+func BenchmarkCheckSliceLength(b *testing.B) {
+	got := []int{1, 2, 3}
+	matcher := match.SliceLength[int](match.Equal(3))
+	b.ReportAllocs()
+	for b.Loop() {
+		match.Check(got, matcher)
+	}
+}
+
+func BenchmarkCheckMapLength(b *testing.B) {
+	got := map[string]int{"a": 1}
+	matcher := match.MapLength[string, int](match.Equal(1))
+	b.ReportAllocs()
+	for b.Loop() {
+		match.Check(got, matcher)
+	}
+}
+
+func BenchmarkCheckStringLength(b *testing.B) {
+	matcher := match.StringLength(match.Equal(5))
+	b.ReportAllocs()
+	for b.Loop() {
+		match.Check("hello", matcher)
+	}
+}
+
+func BenchmarkMatchAllOf(b *testing.B) {
+	matcher := match.AllOf(match.GreaterThan(10), match.LessThan(100), match.NotEqual(7))
+	b.ReportAllocs()
+	for b.Loop() {
+		matcher.Match(42)
+	}
+}
@@ -0,0 +1,353 @@
+package match
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/krelinga/go-match/matchfmt"
+	"github.com/krelinga/go-typemap"
+)
+
+func setElements[T, E any](tm elementsTm[T, E], v T) []E {
+	elems := make([]E, tm.Length(v))
+	for i := range elems {
+		elems[i] = elementAt(tm, v, i)
+	}
+	return elems
+}
+
+// elemSet holds elements for membership tests.
+type elemSet[E any] interface {
+	add(e E)
+	contains(e E) bool
+}
+
+// hashSet is the elemSet for comparable elements.
+type hashSet[E comparable] map[E]struct{}
+
+func (s hashSet[E]) add(e E) {
+	s[e] = struct{}{}
+}
+
+func (s hashSet[E]) contains(e E) bool {
+	_, ok := s[e]
+	return ok
+}
+
+func newHashSet[E comparable]() elemSet[E] {
+	return hashSet[E]{}
+}
+
+// scanSet is the elemSet for elements compared with a typemap.Compare, which
+// can only be searched linearly.
+type scanSet[E any] struct {
+	elemTm typemap.Compare[E]
+	elems  []E
+}
+
+func (s *scanSet[E]) add(e E) {
+	s.elems = append(s.elems, e)
+}
+
+func (s *scanSet[E]) contains(e E) bool {
+	for _, x := range s.elems {
+		if s.elemTm.Compare(x, e) {
+			return true
+		}
+	}
+	return false
+}
+
+func newScanSet[E any](elemTm typemap.Compare[E]) func() elemSet[E] {
+	return func() elemSet[E] {
+		return &scanSet[E]{elemTm: elemTm}
+	}
+}
+
+// setDifference returns the distinct elements of a that are not in b.
+func setDifference[E any](newSet func() elemSet[E], a, b []E) []E {
+	return setFilter(newSet, a, b, false)
+}
+
+// setIntersection returns the distinct elements of a that are also in b.
+func setIntersection[E any](newSet func() elemSet[E], a, b []E) []E {
+	return setFilter(newSet, a, b, true)
+}
+
+// setFilter returns the distinct elements of a whose membership in b is
+// inB.
+func setFilter[E any](newSet func() elemSet[E], a, b []E, inB bool) []E {
+	bSet := newSet()
+	for _, e := range b {
+		bSet.add(e)
+	}
+	seen := newSet()
+	var out []E
+	for _, e := range a {
+		if bSet.contains(e) == inB && !seen.contains(e) {
+			seen.add(e)
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+type duplicate struct {
+	first   int
+	indices []int
+}
+
+// duplicateGroups returns the groups with more than one index.
+func duplicateGroups(groups []duplicate) []duplicate {
+	var dups []duplicate
+	for _, g := range groups {
+		if len(g.indices) > 1 {
+			dups = append(dups, g)
+		}
+	}
+	return dups
+}
+
+// findDuplicatesBy groups the indices of elements with the same key.
+func findDuplicatesBy[E any, K comparable](elems []E, key func(E) K) []duplicate {
+	var groups []duplicate
+	groupOf := make(map[K]int, len(elems))
+	for i, e := range elems {
+		k := key(e)
+		if g, ok := groupOf[k]; ok {
+			groups[g].indices = append(groups[g].indices, i)
+			continue
+		}
+		groupOf[k] = len(groups)
+		groups = append(groups, duplicate{first: i, indices: []int{i}})
+	}
+	return duplicateGroups(groups)
+}
+
+// findDuplicatesFunc groups the indices of elements that are the same
+// according to same.
+func findDuplicatesFunc[E any](elems []E, same func(a, b E) bool) []duplicate {
+	var groups []duplicate
+	for i, e := range elems {
+		found := false
+		for g := range groups {
+			if same(elems[groups[g].first], e) {
+				groups[g].indices = append(groups[g].indices, i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			groups = append(groups, duplicate{first: i, indices: []int{i}})
+		}
+	}
+	return duplicateGroups(groups)
+}
+
+func identity[E any](e E) E {
+	return e
+}
+
+func noDuplicatesImpl[T, E any](tm elementsTm[T, E], elemTm typemap.String[E], name string, find func(elems []E) []duplicate) Matcher[T] {
+	return nodeMatcher[T]{
+		describe: func(got T) matchfmt.Node {
+			elems := setElements(tm, got)
+			node := matchfmt.Node{
+				Name:     name,
+				Expected: "no duplicate elements",
+			}
+			dups := find(elems)
+			if len(dups) == 0 {
+				node.Matched = true
+				return node
+			}
+			lines := make([]string, len(dups))
+			for i, d := range dups {
+				lines[i] = fmt.Sprintf("%s at indices %v", elemTm.String(elems[d.first]), d.indices)
+			}
+			node.Details = []matchfmt.Detail{{
+				Label: "duplicated elements:",
+				Text:  strings.Join(lines, "\n"),
+			}}
+			return node
+		},
+	}
+}
+
+func SliceHasNoDuplicatesTm[T, E any](tm interface {
+	typemap.Length[T]
+	typemap.GetValue[T, int, E]
+}, elemTm interface {
+	typemap.String[E]
+	typemap.Compare[E]
+}) Matcher[T] {
+	return noDuplicatesImpl(tm, elemTm, "match.SliceHasNoDuplicatesTm", func(elems []E) []duplicate {
+		return findDuplicatesFunc(elems, elemTm.Compare)
+	})
+}
+
+func SliceLikeHasNoDuplicates[T ~[]E, E comparable]() Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	elemTm := typemap.StringFunc[E](DefaultString[E])
+	return noDuplicatesImpl(tm, elemTm, "match.SliceLikeHasNoDuplicates", func(elems []E) []duplicate {
+		return findDuplicatesBy(elems, identity[E])
+	})
+}
+
+func SliceHasNoDuplicates[E comparable]() Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	elemTm := typemap.StringFunc[E](DefaultString[E])
+	return noDuplicatesImpl(tm, elemTm, "match.SliceHasNoDuplicates", func(elems []E) []duplicate {
+		return findDuplicatesBy(elems, identity[E])
+	})
+}
+
+func SliceLikeHasNoDuplicatesBy[T ~[]E, E any, K comparable](key func(E) K) Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	elemTm := typemap.StringFunc[E](DefaultString[E])
+	return noDuplicatesImpl(tm, elemTm, "match.SliceLikeHasNoDuplicatesBy", func(elems []E) []duplicate {
+		return findDuplicatesBy(elems, key)
+	})
+}
+
+func SliceHasNoDuplicatesBy[E any, K comparable](key func(E) K) Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	elemTm := typemap.StringFunc[E](DefaultString[E])
+	return noDuplicatesImpl(tm, elemTm, "match.SliceHasNoDuplicatesBy", func(elems []E) []duplicate {
+		return findDuplicatesBy(elems, key)
+	})
+}
+
+// setRelation is one of the relations between got and another set that the
+// set matchers check.
+type setRelation int
+
+const (
+	setSubset setRelation = iota
+	setSuperset
+	setDisjoint
+	setEqual
+)
+
+func setRelationImpl[T, E any](tm elementsTm[T, E], elemTm typemap.String[E], newSet func() elemSet[E], name string, relation setRelation, other T) Matcher[T] {
+	otherElems := setElements(tm, other)
+	otherString := keyList(elemTm, otherElems)
+	var expected string
+	switch relation {
+	case setSubset:
+		expected = fmt.Sprintf("every element of got is in %s", otherString)
+	case setSuperset:
+		expected = fmt.Sprintf("got contains every element of %s", otherString)
+	case setDisjoint:
+		expected = fmt.Sprintf("got has no elements in common with %s", otherString)
+	case setEqual:
+		expected = fmt.Sprintf("got has the same set of elements as %s", otherString)
+	}
+	return nodeMatcher[T]{
+		describe: func(got T) matchfmt.Node {
+			elems := setElements(tm, got)
+			node := matchfmt.Node{
+				Name:     name,
+				Expected: expected,
+			}
+			addList := func(label string, list []E) {
+				if len(list) > 0 {
+					node.Details = append(node.Details, matchfmt.Detail{
+						Text: fmt.Sprintf("%s: %s", label, keyList(elemTm, list)),
+					})
+				}
+			}
+			if relation == setSuperset || relation == setEqual {
+				addList("missing elements", setDifference(newSet, otherElems, elems))
+			}
+			if relation == setSubset || relation == setEqual {
+				addList("extra elements", setDifference(newSet, elems, otherElems))
+			}
+			if relation == setDisjoint {
+				addList("common elements", setIntersection(newSet, elems, otherElems))
+			}
+			node.Matched = len(node.Details) == 0
+			return node
+		},
+	}
+}
+
+func SliceIsSubsetOfTm[T, E any](tm interface {
+	typemap.Length[T]
+	typemap.GetValue[T, int, E]
+}, elemTm interface {
+	typemap.String[E]
+	typemap.Compare[E]
+}, other T) Matcher[T] {
+	return setRelationImpl(tm, elemTm, newScanSet[E](elemTm), "match.SliceIsSubsetOfTm", setSubset, other)
+}
+
+func SliceLikeIsSubsetOf[T ~[]E, E comparable](other T) Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	return setRelationImpl(tm, typemap.StringFunc[E](DefaultString[E]), newHashSet[E], "match.SliceLikeIsSubsetOf", setSubset, other)
+}
+
+func SliceIsSubsetOf[E comparable](other []E) Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	return setRelationImpl(tm, typemap.StringFunc[E](DefaultString[E]), newHashSet[E], "match.SliceIsSubsetOf", setSubset, other)
+}
+
+func SliceIsSupersetOfTm[T, E any](tm interface {
+	typemap.Length[T]
+	typemap.GetValue[T, int, E]
+}, elemTm interface {
+	typemap.String[E]
+	typemap.Compare[E]
+}, other T) Matcher[T] {
+	return setRelationImpl(tm, elemTm, newScanSet[E](elemTm), "match.SliceIsSupersetOfTm", setSuperset, other)
+}
+
+func SliceLikeIsSupersetOf[T ~[]E, E comparable](other T) Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	return setRelationImpl(tm, typemap.StringFunc[E](DefaultString[E]), newHashSet[E], "match.SliceLikeIsSupersetOf", setSuperset, other)
+}
+
+func SliceIsSupersetOf[E comparable](other []E) Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	return setRelationImpl(tm, typemap.StringFunc[E](DefaultString[E]), newHashSet[E], "match.SliceIsSupersetOf", setSuperset, other)
+}
+
+func SliceIsDisjointFromTm[T, E any](tm interface {
+	typemap.Length[T]
+	typemap.GetValue[T, int, E]
+}, elemTm interface {
+	typemap.String[E]
+	typemap.Compare[E]
+}, other T) Matcher[T] {
+	return setRelationImpl(tm, elemTm, newScanSet[E](elemTm), "match.SliceIsDisjointFromTm", setDisjoint, other)
+}
+
+func SliceLikeIsDisjointFrom[T ~[]E, E comparable](other T) Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	return setRelationImpl(tm, typemap.StringFunc[E](DefaultString[E]), newHashSet[E], "match.SliceLikeIsDisjointFrom", setDisjoint, other)
+}
+
+func SliceIsDisjointFrom[E comparable](other []E) Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	return setRelationImpl(tm, typemap.StringFunc[E](DefaultString[E]), newHashSet[E], "match.SliceIsDisjointFrom", setDisjoint, other)
+}
+
+func SliceSetEqualTm[T, E any](tm interface {
+	typemap.Length[T]
+	typemap.GetValue[T, int, E]
+}, elemTm interface {
+	typemap.String[E]
+	typemap.Compare[E]
+}, want T) Matcher[T] {
+	return setRelationImpl(tm, elemTm, newScanSet[E](elemTm), "match.SliceSetEqualTm", setEqual, want)
+}
+
+func SliceLikeSetEqual[T ~[]E, E comparable](want T) Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	return setRelationImpl(tm, typemap.StringFunc[E](DefaultString[E]), newHashSet[E], "match.SliceLikeSetEqual", setEqual, want)
+}
+
+func SliceSetEqual[E comparable](want []E) Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	return setRelationImpl(tm, typemap.StringFunc[E](DefaultString[E]), newHashSet[E], "match.SliceSetEqual", setEqual, want)
+}
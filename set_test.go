@@ -0,0 +1,298 @@
+package match_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-typemap"
+)
+
+type intSliceCompare struct{}
+
+func (intSliceCompare) Compare(a, b []int) bool {
+	return slices.Equal(a, b)
+}
+
+var intSliceElemTm = struct {
+	typemap.StringFunc[[]int]
+	intSliceCompare
+}{
+	StringFunc: match.DefaultString[[]int],
+}
+
+func TestSliceHasNoDuplicates(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]string]
+		value   []string
+		want    bool
+	}{
+		{
+			name:    "unique",
+			matcher: match.SliceHasNoDuplicates[string](),
+			value:   []string{"a", "b", "c"},
+			want:    true,
+		},
+		{
+			name:    "duplicates",
+			matcher: match.SliceHasNoDuplicates[string](),
+			value:   []string{"a", "b", "a", "c", "b", "a"},
+			want:    false,
+		},
+		{
+			name:    "by_key",
+			matcher: match.SliceHasNoDuplicatesBy(strings.ToLower),
+			value:   []string{"a", "B", "b"},
+			want:    false,
+		},
+		{
+			name:    "like",
+			matcher: match.SliceLikeHasNoDuplicates[[]string](),
+			value:   []string{"a", "b"},
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceIsSubsetOf(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "subset",
+			matcher: match.SliceIsSubsetOf([]int{1, 2, 3}),
+			value:   []int{3, 1, 1},
+			want:    true,
+		},
+		{
+			name:    "extra_elements",
+			matcher: match.SliceIsSubsetOf([]int{1, 2, 3}),
+			value:   []int{1, 4, 5, 4},
+			want:    false,
+		},
+		{
+			name:    "like",
+			matcher: match.SliceLikeIsSubsetOf([]int{1}),
+			value:   []int{2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceIsSupersetOf(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "superset",
+			matcher: match.SliceIsSupersetOf([]int{1, 2}),
+			value:   []int{2, 3, 1},
+			want:    true,
+		},
+		{
+			name:    "missing_elements",
+			matcher: match.SliceIsSupersetOf([]int{1, 2, 3}),
+			value:   []int{2},
+			want:    false,
+		},
+		{
+			name:    "like",
+			matcher: match.SliceLikeIsSupersetOf([]int{1}),
+			value:   []int{1},
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceIsDisjointFrom(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "disjoint",
+			matcher: match.SliceIsDisjointFrom([]int{1, 2}),
+			value:   []int{3, 4},
+			want:    true,
+		},
+		{
+			name:    "common_elements",
+			matcher: match.SliceIsDisjointFrom([]int{1, 2, 3}),
+			value:   []int{3, 4, 1, 3},
+			want:    false,
+		},
+		{
+			name:    "like",
+			matcher: match.SliceLikeIsDisjointFrom([]int{1}),
+			value:   nil,
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceSetEqual(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "equal_ignoring_order_and_repeats",
+			matcher: match.SliceSetEqual([]int{1, 2, 3}),
+			value:   []int{3, 2, 1, 2},
+			want:    true,
+		},
+		{
+			name:    "missing_and_extra",
+			matcher: match.SliceSetEqual([]int{1, 2, 3}),
+			value:   []int{1, 4},
+			want:    false,
+		},
+		{
+			name:    "like",
+			matcher: match.SliceLikeSetEqual([]int{1}),
+			value:   []int{2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceSetTm(t *testing.T) {
+	goldie := newGoldie(t)
+	tm := typemap.ForSlice[[]int]{}
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[][]int]
+		value   [][]int
+		want    bool
+	}{
+		{
+			name:    "no_duplicates",
+			matcher: match.SliceHasNoDuplicatesTm(tm, intSliceElemTm),
+			value:   [][]int{{1}, {1, 2}, {1}},
+			want:    false,
+		},
+		{
+			name:    "subset",
+			matcher: match.SliceIsSubsetOfTm(tm, intSliceElemTm, [][]int{{1}, {2}}),
+			value:   [][]int{{2}},
+			want:    true,
+		},
+		{
+			name:    "superset",
+			matcher: match.SliceIsSupersetOfTm(tm, intSliceElemTm, [][]int{{1}, {2}}),
+			value:   [][]int{{2}},
+			want:    false,
+		},
+		{
+			name:    "disjoint",
+			matcher: match.SliceIsDisjointFromTm(tm, intSliceElemTm, [][]int{{1}, {2}}),
+			value:   [][]int{{1, 2}},
+			want:    true,
+		},
+		{
+			name:    "set_equal",
+			matcher: match.SliceSetEqualTm(tm, intSliceElemTm, [][]int{{1}, {2}}),
+			value:   [][]int{{2}, {1}, {3}},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceSetMatchersLargeInput(t *testing.T) {
+	const n = 200_000
+	large := make([]int, n)
+	for i := range large {
+		large[i] = i
+	}
+	shuffled := slices.Clone(large)
+	slices.Reverse(shuffled)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+	}{
+		{name: "has_no_duplicates", matcher: match.SliceHasNoDuplicates[int]()},
+		{name: "has_no_duplicates_by", matcher: match.SliceHasNoDuplicatesBy(func(i int) int { return i })},
+		{name: "is_subset_of", matcher: match.SliceIsSubsetOf(shuffled)},
+		{name: "is_superset_of", matcher: match.SliceIsSupersetOf(shuffled)},
+		{name: "set_equal", matcher: match.SliceSetEqual(shuffled)},
+		{name: "is_disjoint_from", matcher: match.SliceIsDisjointFrom([]int{-1, n})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if matched, explanation := tt.matcher.Match(large); !matched {
+				t.Errorf("expected match, got:\n%s", explanation)
+			}
+		})
+	}
+}
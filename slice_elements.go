@@ -0,0 +1,272 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/krelinga/go-match/matchfmt"
+	"github.com/krelinga/go-typemap"
+)
+
+type elementsTm[T, E any] interface {
+	typemap.Length[T]
+	typemap.GetValue[T, int, E]
+}
+
+func elementAt[T, E any](tm elementsTm[T, E], got T, index int) E {
+	e, _ := tm.GetValue(got, index)
+	return e
+}
+
+func elementsAreImpl[T, E any](tm elementsTm[T, E], name string, matchers []Matcher[E]) Matcher[T] {
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(matchers...),
+		matches: func(got T) bool {
+			if tm.Length(got) != len(matchers) {
+				return false
+			}
+			for i, matcher := range matchers {
+				if !Matches(elementAt(tm, got, i), matcher) {
+					return false
+				}
+			}
+			return true
+		},
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{Name: name}
+			length := tm.Length(got)
+			if length != len(matchers) {
+				node.Expected = fmt.Sprintf("length == %d", len(matchers))
+				node.Actual = fmt.Sprintf("length == %d", length)
+				return node
+			}
+			node.Matched = true
+			var failing []int
+			for i, matcher := range matchers {
+				inner := Describe(elementAt(tm, got, i), matcher)
+				if !inner.Matched {
+					node.Matched = false
+					failing = append(failing, i)
+				}
+				node.Details = append(node.Details, matchfmt.Detail{
+					Label: fmt.Sprintf("index %d:", i),
+					Node:  &inner,
+				})
+			}
+			if !node.Matched {
+				summary := matchfmt.Detail{Text: fmt.Sprintf("failing indices: %v", failing)}
+				node.Details = append([]matchfmt.Detail{summary}, node.Details...)
+			}
+			return node
+		},
+	}
+}
+
+func SliceLikeElementsAre[T ~[]E, E any](matchers ...Matcher[E]) Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	return elementsAreImpl(tm, "match.SliceLikeElementsAre", matchers)
+}
+
+func SliceElementsAre[E any](matchers ...Matcher[E]) Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	return elementsAreImpl(tm, "match.SliceElementsAre", matchers)
+}
+
+func containsImpl[T, E any](tm elementsTm[T, E], name string, matcher Matcher[E]) Matcher[T] {
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(matcher),
+		matches: func(got T) bool {
+			for i := range tm.Length(got) {
+				if Matches(elementAt(tm, got, i), matcher) {
+					return true
+				}
+			}
+			return false
+		},
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{Name: name}
+			length := tm.Length(got)
+			for i := 0; i < length; i++ {
+				inner := Describe(elementAt(tm, got, i), matcher)
+				if inner.Matched {
+					node.Matched = true
+					node.Details = []matchfmt.Detail{{
+						Label: fmt.Sprintf("index %d matches:", i),
+						Node:  &inner,
+					}}
+					return node
+				}
+				node.Details = append(node.Details, matchfmt.Detail{
+					Label: fmt.Sprintf("index %d:", i),
+					Node:  &inner,
+				})
+			}
+			summary := matchfmt.Detail{Text: fmt.Sprintf("none of %d elements match", length)}
+			node.Details = append([]matchfmt.Detail{summary}, node.Details...)
+			return node
+		},
+	}
+}
+
+func SliceLikeContains[T ~[]E, E any](matcher Matcher[E]) Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	return containsImpl(tm, "match.SliceLikeContains", matcher)
+}
+
+func SliceContains[E any](matcher Matcher[E]) Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	return containsImpl(tm, "match.SliceContains", matcher)
+}
+
+func eachImpl[T, E any](tm elementsTm[T, E], name string, matcher Matcher[E]) Matcher[T] {
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(matcher),
+		matches: func(got T) bool {
+			for i := range tm.Length(got) {
+				if !Matches(elementAt(tm, got, i), matcher) {
+					return false
+				}
+			}
+			return true
+		},
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{Matched: true, Name: name}
+			length := tm.Length(got)
+			var failing []int
+			for i := 0; i < length; i++ {
+				inner := Describe(elementAt(tm, got, i), matcher)
+				if !inner.Matched {
+					node.Matched = false
+					failing = append(failing, i)
+					node.Details = append(node.Details, matchfmt.Detail{
+						Label: fmt.Sprintf("index %d:", i),
+						Node:  &inner,
+					})
+				}
+			}
+			if node.Matched {
+				node.Expected = fmt.Sprintf("all %d elements match", length)
+			} else {
+				summary := matchfmt.Detail{Text: fmt.Sprintf("failing indices: %v", failing)}
+				node.Details = append([]matchfmt.Detail{summary}, node.Details...)
+			}
+			return node
+		},
+	}
+}
+
+func SliceLikeEach[T ~[]E, E any](matcher Matcher[E]) Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	return eachImpl(tm, "match.SliceLikeEach", matcher)
+}
+
+func SliceEach[E any](matcher Matcher[E]) Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	return eachImpl(tm, "match.SliceEach", matcher)
+}
+
+// bipartiteMatch finds a maximum matching between elements and matchers,
+// where edges[i][j] reports whether element i satisfies matcher j.  It
+// returns, for each matcher, the index of the element assigned to it, or -1.
+func bipartiteMatch(edges [][]bool, numMatchers int) []int {
+	elementFor := make([]int, numMatchers)
+	for j := range elementFor {
+		elementFor[j] = -1
+	}
+	var augment func(i int, seen []bool) bool
+	augment = func(i int, seen []bool) bool {
+		for j := 0; j < numMatchers; j++ {
+			if !edges[i][j] || seen[j] {
+				continue
+			}
+			seen[j] = true
+			if elementFor[j] == -1 || augment(elementFor[j], seen) {
+				elementFor[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for i := range edges {
+		augment(i, make([]bool, numMatchers))
+	}
+	return elementFor
+}
+
+func unorderedElementsAreImpl[T, E any](tm elementsTm[T, E], name string, matchers []Matcher[E]) Matcher[T] {
+	return nodeMatcher[T]{
+		impure: !sideEffectFree(matchers...),
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{Name: name}
+			length := tm.Length(got)
+			if length != len(matchers) {
+				node.Expected = fmt.Sprintf("length == %d", len(matchers))
+				node.Actual = fmt.Sprintf("length == %d", length)
+				return node
+			}
+			edges := make([][]bool, length)
+			inners := make([][]matchfmt.Node, length)
+			for i := range edges {
+				edges[i] = make([]bool, len(matchers))
+				inners[i] = make([]matchfmt.Node, len(matchers))
+				for j, matcher := range matchers {
+					inners[i][j] = Describe(elementAt(tm, got, i), matcher)
+					edges[i][j] = inners[i][j].Matched
+				}
+			}
+			elementFor := bipartiteMatch(edges, len(matchers))
+
+			assigned := make([]bool, length)
+			var unmatchedMatchers []int
+			for j, i := range elementFor {
+				if i == -1 {
+					unmatchedMatchers = append(unmatchedMatchers, j)
+				} else {
+					assigned[i] = true
+				}
+			}
+			var unmatchedElements []int
+			for i, ok := range assigned {
+				if !ok {
+					unmatchedElements = append(unmatchedElements, i)
+				}
+			}
+
+			node.Matched = len(unmatchedMatchers) == 0
+			if node.Matched {
+				for j, i := range elementFor {
+					node.Details = append(node.Details, matchfmt.Detail{
+						Label: fmt.Sprintf("index %d matched by matcher %d:", i, j),
+						Node:  &inners[i][j],
+					})
+				}
+				return node
+			}
+			node.Details = append(node.Details,
+				matchfmt.Detail{Text: "no one-to-one assignment between elements and matchers"},
+				matchfmt.Detail{Text: fmt.Sprintf("unmatched indices: %v", unmatchedElements)},
+				matchfmt.Detail{Text: fmt.Sprintf("unmatched matchers: %v", unmatchedMatchers)},
+			)
+			for _, j := range unmatchedMatchers {
+				detail := matchfmt.Detail{Label: fmt.Sprintf("matcher %d:", j)}
+				for _, i := range unmatchedElements {
+					detail.Details = append(detail.Details, matchfmt.Detail{
+						Label: fmt.Sprintf("index %d:", i),
+						Node:  &inners[i][j],
+					})
+				}
+				node.Details = append(node.Details, detail)
+			}
+			return node
+		},
+	}
+}
+
+func SliceLikeUnorderedElementsAre[T ~[]E, E any](matchers ...Matcher[E]) Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	return unorderedElementsAreImpl(tm, "match.SliceLikeUnorderedElementsAre", matchers)
+}
+
+func SliceUnorderedElementsAre[E any](matchers ...Matcher[E]) Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	return unorderedElementsAreImpl(tm, "match.SliceUnorderedElementsAre", matchers)
+}
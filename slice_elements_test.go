@@ -0,0 +1,305 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-match"
+)
+
+func TestSliceElementsAre(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "all_elements_match",
+			matcher: match.SliceElementsAre(match.Equal(1), match.Equal(2), match.Equal(3)),
+			value:   []int{1, 2, 3},
+			want:    true,
+		},
+		{
+			name:    "some_elements_do_not_match",
+			matcher: match.SliceElementsAre(match.Equal(1), match.Equal(5), match.Equal(6)),
+			value:   []int{1, 2, 3},
+			want:    false,
+		},
+		{
+			name:    "length_mismatch",
+			matcher: match.SliceElementsAre(match.Equal(1), match.Equal(2)),
+			value:   []int{1, 2, 3},
+			want:    false,
+		},
+		{
+			name:    "empty_slice_no_matchers",
+			matcher: match.SliceElementsAre[int](),
+			value:   nil,
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceLikeElementsAre(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "all_elements_match",
+			matcher: match.SliceLikeElementsAre[[]int](match.Equal(1), match.Equal(2)),
+			value:   []int{1, 2},
+			want:    true,
+		},
+		{
+			name:    "some_elements_do_not_match",
+			matcher: match.SliceLikeElementsAre[[]int](match.Equal(1), match.Equal(5)),
+			value:   []int{1, 2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceContains(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "element_found",
+			matcher: match.SliceContains(match.GreaterThan(2)),
+			value:   []int{1, 2, 3},
+			want:    true,
+		},
+		{
+			name:    "element_not_found",
+			matcher: match.SliceContains(match.GreaterThan(5)),
+			value:   []int{1, 2, 3},
+			want:    false,
+		},
+		{
+			name:    "empty_slice",
+			matcher: match.SliceContains(match.Equal(1)),
+			value:   nil,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceLikeContains(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "element_found",
+			matcher: match.SliceLikeContains[[]int](match.Equal(2)),
+			value:   []int{1, 2, 3},
+			want:    true,
+		},
+		{
+			name:    "element_not_found",
+			matcher: match.SliceLikeContains[[]int](match.Equal(4)),
+			value:   []int{1, 2, 3},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceEach(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "all_elements_match",
+			matcher: match.SliceEach(match.GreaterThan(0)),
+			value:   []int{1, 2, 3},
+			want:    true,
+		},
+		{
+			name:    "some_elements_do_not_match",
+			matcher: match.SliceEach(match.LessThan(2)),
+			value:   []int{1, 2, 3},
+			want:    false,
+		},
+		{
+			name:    "empty_slice",
+			matcher: match.SliceEach(match.Equal(1)),
+			value:   nil,
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceLikeEach(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "all_elements_match",
+			matcher: match.SliceLikeEach[[]int](match.GreaterThan(0)),
+			value:   []int{1, 2, 3},
+			want:    true,
+		},
+		{
+			name:    "some_elements_do_not_match",
+			matcher: match.SliceLikeEach[[]int](match.NotEqual(2)),
+			value:   []int{1, 2, 3},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceUnorderedElementsAre(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "same_order",
+			matcher: match.SliceUnorderedElementsAre(match.Equal(1), match.Equal(2)),
+			value:   []int{1, 2},
+			want:    true,
+		},
+		{
+			name:    "different_order",
+			matcher: match.SliceUnorderedElementsAre(match.Equal(2), match.Equal(1)),
+			value:   []int{1, 2},
+			want:    true,
+		},
+		{
+			name:    "requires_reassignment",
+			matcher: match.SliceUnorderedElementsAre(match.LessThan(3), match.Equal(1)),
+			value:   []int{1, 2},
+			want:    true,
+		},
+		{
+			name:    "no_assignment",
+			matcher: match.SliceUnorderedElementsAre(match.Equal(1), match.Equal(1), match.Equal(3)),
+			value:   []int{1, 2, 3},
+			want:    false,
+		},
+		{
+			name:    "length_mismatch",
+			matcher: match.SliceUnorderedElementsAre(match.Equal(1)),
+			value:   []int{1, 2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceLikeUnorderedElementsAre(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "different_order",
+			matcher: match.SliceLikeUnorderedElementsAre[[]int](match.Equal(2), match.Equal(1)),
+			value:   []int{1, 2},
+			want:    true,
+		},
+		{
+			name:    "no_assignment",
+			matcher: match.SliceLikeUnorderedElementsAre[[]int](match.Equal(2), match.Equal(2)),
+			value:   []int{1, 2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package match
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/krelinga/go-match/matchfmt"
+	"github.com/krelinga/go-typemap"
+)
+
+type orderFunc[T any] func(a, b T) int
+
+func (f orderFunc[T]) Order(a, b T) int {
+	return f(a, b)
+}
+
+type sortedElementTm[E any] interface {
+	typemap.String[E]
+	typemap.Order[E]
+}
+
+// firstUnsorted returns the index i of the first adjacent pair got[i],
+// got[i+1] that is out of order, or -1 if there is none.
+func firstUnsorted[T, E any](tm elementsTm[T, E], elemTm typemap.Order[E], strict bool, got T) int {
+	length := tm.Length(got)
+	for i := 0; i+1 < length; i++ {
+		c := elemTm.Order(elementAt(tm, got, i), elementAt(tm, got, i+1))
+		if c > 0 || (strict && c == 0) {
+			return i
+		}
+	}
+	return -1
+}
+
+func sortedImpl[T, E any](tm elementsTm[T, E], elemTm sortedElementTm[E], name string, strict bool) Matcher[T] {
+	op, notOp := "<=", ">"
+	if strict {
+		op, notOp = "<", ">="
+	}
+	return nodeMatcher[T]{
+		matches: func(got T) bool {
+			return firstUnsorted(tm, elemTm, strict, got) == -1
+		},
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Name:     name,
+				Expected: fmt.Sprintf("got[i] %s got[i+1] for all i", op),
+			}
+			i := firstUnsorted(tm, elemTm, strict, got)
+			if i == -1 {
+				node.Matched = true
+				return node
+			}
+			node.Actual = fmt.Sprintf("got[%d] == %s %s got[%d] == %s",
+				i, elemTm.String(elementAt(tm, got, i)), notOp, i+1, elemTm.String(elementAt(tm, got, i+1)))
+			return node
+		},
+	}
+}
+
+func defaultSortedElementTm[E cmp.Ordered]() sortedElementTm[E] {
+	return struct {
+		typemap.StringFunc[E]
+		typemap.DefaultOrder[E]
+	}{
+		StringFunc: DefaultString[E],
+	}
+}
+
+func SliceIsSortedTm[T, E any](tm interface {
+	typemap.Length[T]
+	typemap.GetValue[T, int, E]
+}, elemTm interface {
+	typemap.String[E]
+	typemap.Order[E]
+}) Matcher[T] {
+	return sortedImpl(tm, elemTm, "match.SliceIsSortedTm", false)
+}
+
+func SliceLikeIsSorted[T ~[]E, E cmp.Ordered]() Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	return sortedImpl(tm, defaultSortedElementTm[E](), "match.SliceLikeIsSorted", false)
+}
+
+func SliceIsSorted[E cmp.Ordered]() Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	return sortedImpl(tm, defaultSortedElementTm[E](), "match.SliceIsSorted", false)
+}
+
+func SliceLikeIsSortedBy[T ~[]E, E any](order func(a, b E) int) Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	elemTm := struct {
+		typemap.StringFunc[E]
+		orderFunc[E]
+	}{
+		StringFunc: DefaultString[E],
+		orderFunc:  order,
+	}
+	return sortedImpl(tm, elemTm, "match.SliceLikeIsSortedBy", false)
+}
+
+func SliceIsSortedBy[E any](order func(a, b E) int) Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	elemTm := struct {
+		typemap.StringFunc[E]
+		orderFunc[E]
+	}{
+		StringFunc: DefaultString[E],
+		orderFunc:  order,
+	}
+	return sortedImpl(tm, elemTm, "match.SliceIsSortedBy", false)
+}
+
+func SliceLikeIsStrictlyIncreasing[T ~[]E, E cmp.Ordered]() Matcher[T] {
+	tm := typemap.ForSliceLike[T, E]{}
+	return sortedImpl(tm, defaultSortedElementTm[E](), "match.SliceLikeIsStrictlyIncreasing", true)
+}
+
+func SliceIsStrictlyIncreasing[E cmp.Ordered]() Matcher[[]E] {
+	tm := typemap.ForSlice[E]{}
+	return sortedImpl(tm, defaultSortedElementTm[E](), "match.SliceIsStrictlyIncreasing", true)
+}
@@ -0,0 +1,197 @@
+package match_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-typemap"
+)
+
+type sortedInts []int
+
+type person struct {
+	Name string
+	Age  int
+}
+
+func TestSliceIsSorted(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "sorted",
+			matcher: match.SliceIsSorted[int](),
+			value:   []int{1, 2, 2, 3},
+			want:    true,
+		},
+		{
+			name:    "empty",
+			matcher: match.SliceIsSorted[int](),
+			value:   nil,
+			want:    true,
+		},
+		{
+			name:    "out_of_order",
+			matcher: match.SliceIsSorted[int](),
+			value:   []int{1, 3, 2, 0},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceLikeIsSorted(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[sortedInts]
+		value   sortedInts
+		want    bool
+	}{
+		{
+			name:    "sorted",
+			matcher: match.SliceLikeIsSorted[sortedInts](),
+			value:   sortedInts{1, 2},
+			want:    true,
+		},
+		{
+			name:    "out_of_order",
+			matcher: match.SliceLikeIsSorted[sortedInts](),
+			value:   sortedInts{2, 1},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceIsSortedBy(t *testing.T) {
+	goldie := newGoldie(t)
+	byAge := func(a, b person) int {
+		return cmp.Compare(a.Age, b.Age)
+	}
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]person]
+		value   []person
+		want    bool
+	}{
+		{
+			name:    "sorted",
+			matcher: match.SliceIsSortedBy(byAge),
+			value:   []person{{"b", 20}, {"a", 30}},
+			want:    true,
+		},
+		{
+			name:    "out_of_order",
+			matcher: match.SliceIsSortedBy(byAge),
+			value:   []person{{"a", 30}, {"b", 20}},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceIsStrictlyIncreasing(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]int]
+		value   []int
+		want    bool
+	}{
+		{
+			name:    "increasing",
+			matcher: match.SliceIsStrictlyIncreasing[int](),
+			value:   []int{1, 2, 3},
+			want:    true,
+		},
+		{
+			name:    "repeated_element",
+			matcher: match.SliceIsStrictlyIncreasing[int](),
+			value:   []int{1, 2, 2, 3},
+			want:    false,
+		},
+		{
+			name:    "like",
+			matcher: match.Transform("as sortedInts", func(s []int) sortedInts { return s }, match.SliceLikeIsStrictlyIncreasing[sortedInts]()),
+			value:   []int{3, 2},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestSliceIsSortedTm(t *testing.T) {
+	goldie := newGoldie(t)
+	elemTm := struct {
+		typemap.StringFunc[string]
+		reverseStringOrder
+	}{
+		StringFunc: match.DefaultString[string],
+	}
+	tests := []struct {
+		name    string
+		matcher match.Matcher[[]string]
+		value   []string
+		want    bool
+	}{
+		{
+			name:    "sorted",
+			matcher: match.SliceIsSortedTm(typemap.ForSlice[string]{}, elemTm),
+			value:   []string{"c", "b", "a"},
+			want:    true,
+		},
+		{
+			name:    "out_of_order",
+			matcher: match.SliceIsSortedTm(typemap.ForSlice[string]{}, elemTm),
+			value:   []string{"c", "a", "b"},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
@@ -9,20 +9,23 @@ import (
 )
 
 func stringLikeContainsImpl[T ~string](tm typemap.String[T], name string, substr string) Matcher[T] {
-	return MatcherFunc[T](func(got T) (match bool, explanation string) {
-		strGot := string(got)
-		match = strings.Contains(strGot, substr)
-		expected := fmt.Sprintf("string contains %q", substr)
-		var detail string
-		if match {
-			detail = expected
-		} else {
-			actual := fmt.Sprintf("string %s does not contain %q", tm.String(got), substr)
-			detail = matchfmt.ActualVsExpected(actual, expected)
-		}
-		explanation = matchfmt.Explain(match, name, detail)
-		return
-	})
+	matches := func(got T) bool {
+		return strings.Contains(string(got), substr)
+	}
+	return nodeMatcher[T]{
+		matches: matches,
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  matches(got),
+				Name:     name,
+				Expected: fmt.Sprintf("string contains %q", substr),
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("string %s does not contain %q", tm.String(got), substr)
+			}
+			return node
+		},
+	}
 }
 
 func StringLikeContainsTm[T ~string](tm typemap.String[T], substr string) Matcher[T] {
@@ -0,0 +1,106 @@
+package match
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+type stringEqualTextOptions struct {
+	context                  int
+	normalizeCRLF            bool
+	ignoreTrailingWhitespace bool
+}
+
+type StringEqualTextOption func(*stringEqualTextOptions)
+
+// StringEqualTextContext sets the number of unchanged lines shown around
+// each change in the diff.  The default is 3, and negative values are
+// treated as 0.
+func StringEqualTextContext(lines int) StringEqualTextOption {
+	return func(o *stringEqualTextOptions) {
+		o.context = max(lines, 0)
+	}
+}
+
+// StringEqualTextNormalizeCRLF treats "\r\n" line endings as "\n".
+func StringEqualTextNormalizeCRLF() StringEqualTextOption {
+	return func(o *stringEqualTextOptions) {
+		o.normalizeCRLF = true
+	}
+}
+
+// StringEqualTextIgnoreTrailingWhitespace ignores spaces and tabs at the end
+// of each line.
+func StringEqualTextIgnoreTrailingWhitespace() StringEqualTextOption {
+	return func(o *stringEqualTextOptions) {
+		o.ignoreTrailingWhitespace = true
+	}
+}
+
+func (o *stringEqualTextOptions) normalize(s string) string {
+	if o.normalizeCRLF {
+		s = strings.ReplaceAll(s, "\r\n", "\n")
+	}
+	if o.ignoreTrailingWhitespace {
+		lines := strings.Split(s, "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(line, " \t")
+		}
+		s = strings.Join(lines, "\n")
+	}
+	return s
+}
+
+func lineCount(s string) int {
+	if s == "" {
+		return 0
+	}
+	n := strings.Count(s, "\n")
+	if !strings.HasSuffix(s, "\n") {
+		n++
+	}
+	return n
+}
+
+// visibleCR makes carriage returns visible in a diff, where they would
+// otherwise make changed lines look identical.
+func visibleCR(s string) string {
+	return strings.ReplaceAll(s, "\r", `\r`)
+}
+
+func stringEqualTextImpl[T ~string](name string, want T, opts []StringEqualTextOption) Matcher[T] {
+	options := &stringEqualTextOptions{context: 3}
+	for _, opt := range opts {
+		opt(options)
+	}
+	normalizedWant := options.normalize(string(want))
+	return nodeMatcher[T]{
+		matches: func(got T) bool {
+			return options.normalize(string(got)) == normalizedWant
+		},
+		describe: func(got T) matchfmt.Node {
+			normalizedGot := options.normalize(string(got))
+			node := matchfmt.Node{Name: name}
+			if normalizedGot == normalizedWant {
+				node.Matched = true
+				node.Expected = fmt.Sprintf("got == want (%d lines)", lineCount(normalizedWant))
+				return node
+			}
+			node.Details = []matchfmt.Detail{{
+				Label: "diff (-want +got):",
+				Text:  matchfmt.UnifiedDiff(visibleCR(normalizedWant), visibleCR(normalizedGot), options.context),
+			}}
+			return node
+		},
+	}
+}
+
+func StringLikeEqualText[T ~string](want T, opts ...StringEqualTextOption) Matcher[T] {
+	return stringEqualTextImpl("match.StringLikeEqualText", want, opts)
+}
+
+func StringEqualText(want string, opts ...StringEqualTextOption) Matcher[string] {
+	return stringEqualTextImpl("match.StringEqualText", want, opts)
+}
@@ -0,0 +1,119 @@
+package match_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/krelinga/go-match"
+)
+
+func configText(port int) string {
+	var lines []string
+	for i := range 20 {
+		lines = append(lines, fmt.Sprintf("setting_%02d = %d", i, i))
+	}
+	lines[10] = fmt.Sprintf("port = %d", port)
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func TestStringEqualText(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "equal",
+			matcher: match.StringEqualText(configText(80)),
+			value:   configText(80),
+			want:    true,
+		},
+		{
+			name:    "one_line_differs",
+			matcher: match.StringEqualText(configText(80)),
+			value:   configText(8080),
+			want:    false,
+		},
+		{
+			name:    "custom_context",
+			matcher: match.StringEqualText(configText(80), match.StringEqualTextContext(1)),
+			value:   configText(8080),
+			want:    false,
+		},
+		{
+			name:    "negative_context",
+			matcher: match.StringEqualText(configText(80), match.StringEqualTextContext(-1)),
+			value:   configText(8080),
+			want:    false,
+		},
+		{
+			name:    "crlf_differs",
+			matcher: match.StringEqualText("a\nb\n"),
+			value:   "a\r\nb\r\n",
+			want:    false,
+		},
+		{
+			name:    "crlf_normalized",
+			matcher: match.StringEqualText("a\nb\n", match.StringEqualTextNormalizeCRLF()),
+			value:   "a\r\nb\r\n",
+			want:    true,
+		},
+		{
+			name:    "trailing_whitespace_ignored",
+			matcher: match.StringEqualText("a\nb", match.StringEqualTextIgnoreTrailingWhitespace()),
+			value:   "a  \nb\t",
+			want:    true,
+		},
+		{
+			name:    "added_and_removed_lines",
+			matcher: match.StringEqualText("one\ntwo\nthree\nfour"),
+			value:   "zero\none\nthree\nfour\nfive",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestStringLikeEqualText(t *testing.T) {
+	type text string
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[text]
+		value   text
+		want    bool
+	}{
+		{
+			name:    "equal",
+			matcher: match.StringLikeEqualText(text("a\nb")),
+			value:   "a\nb",
+			want:    true,
+		},
+		{
+			name:    "differs",
+			matcher: match.StringLikeEqualText(text("a\nb")),
+			value:   "a\nc",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
@@ -9,20 +9,23 @@ import (
 )
 
 func stringLikeHasPrefixImpl[T ~string](tm typemap.String[T], name string, prefix string) Matcher[T] {
-	return MatcherFunc[T](func(got T) (match bool, explanation string) {
-		strGot := string(got)
-		match = strings.HasPrefix(strGot, prefix)
-		expected := fmt.Sprintf("string starts with %q", prefix)
-		var detail string
-		if match {
-			detail = expected
-		} else {
-			actual := fmt.Sprintf("string %s does not start with %q", tm.String(got), prefix)
-			detail = matchfmt.ActualVsExpected(actual, expected)
-		}
-		explanation = matchfmt.Explain(match, name, detail)
-		return
-	})
+	matches := func(got T) bool {
+		return strings.HasPrefix(string(got), prefix)
+	}
+	return nodeMatcher[T]{
+		matches: matches,
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  matches(got),
+				Name:     name,
+				Expected: fmt.Sprintf("string starts with %q", prefix),
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("string %s does not start with %q", tm.String(got), prefix)
+			}
+			return node
+		},
+	}
 }
 
 func StringLikeHasPrefixTm[T ~string](tm typemap.String[T], prefix string) Matcher[T] {
@@ -9,20 +9,23 @@ import (
 )
 
 func stringLikeHasSuffixImpl[T ~string](tm typemap.String[T], name string, suffix string) Matcher[T] {
-	return MatcherFunc[T](func(got T) (match bool, explanation string) {
-		strGot := string(got)
-		match = strings.HasSuffix(strGot, suffix)
-		expected := fmt.Sprintf("string ends with %q", suffix)
-		var detail string
-		if match {
-			detail = expected
-		} else {
-			actual := fmt.Sprintf("string %s does not end with %q", tm.String(got), suffix)
-			detail = matchfmt.ActualVsExpected(actual, expected)
-		}
-		explanation = matchfmt.Explain(match, name, detail)
-		return
-	})
+	matches := func(got T) bool {
+		return strings.HasSuffix(string(got), suffix)
+	}
+	return nodeMatcher[T]{
+		matches: matches,
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  matches(got),
+				Name:     name,
+				Expected: fmt.Sprintf("string ends with %q", suffix),
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("string %s does not end with %q", tm.String(got), suffix)
+			}
+			return node
+		},
+	}
 }
 
 func StringLikeHasSuffixTm[T ~string](tm typemap.String[T], suffix string) Matcher[T] {
@@ -0,0 +1,163 @@
+package match
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/krelinga/go-match/matchfmt"
+	"github.com/krelinga/go-typemap"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// foldString returns the full Unicode case folding of s in NFC form, so that
+// strings which differ only in case or normalization fold to the same value.
+// Full folding maps "ß" to "ss", which strings.EqualFold does not.
+func foldString(s string) string {
+	return norm.NFC.String(cases.Fold().String(norm.NFD.String(s)))
+}
+
+func stringPredicateImpl[T ~string](tm typemap.String[T], name, expected string, pred func(got string) bool) Matcher[T] {
+	matches := func(got T) bool {
+		return pred(string(got))
+	}
+	return nodeMatcher[T]{
+		matches: matches,
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  matches(got),
+				Name:     name,
+				Expected: expected,
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("got == %s", tm.String(got))
+			}
+			return node
+		},
+	}
+}
+
+func stringEqualFoldImpl[T ~string](tm typemap.String[T], name string, want string) Matcher[T] {
+	folded := foldString(want)
+	return stringPredicateImpl(tm, name, fmt.Sprintf("got equals %q ignoring case", want), func(got string) bool {
+		return foldString(got) == folded
+	})
+}
+
+func StringLikeEqualFoldTm[T ~string](tm typemap.String[T], want string) Matcher[T] {
+	return stringEqualFoldImpl(tm, "match.StringLikeEqualFoldTm", want)
+}
+
+func StringLikeEqualFold[T ~string](want string) Matcher[T] {
+	tm := typemap.ForStringLike[T]{
+		StringFunc: DefaultString[T],
+	}
+	return stringEqualFoldImpl(tm, "match.StringLikeEqualFold", want)
+}
+
+func StringEqualFold(want string) Matcher[string] {
+	tm := typemap.ForString{
+		StringFunc: DefaultString[string],
+	}
+	return stringEqualFoldImpl(tm, "match.StringEqualFold", want)
+}
+
+func stringContainsFoldImpl[T ~string](tm typemap.String[T], name string, substr string) Matcher[T] {
+	folded := foldString(substr)
+	return stringPredicateImpl(tm, name, fmt.Sprintf("got contains %q ignoring case", substr), func(got string) bool {
+		return strings.Contains(foldString(got), folded)
+	})
+}
+
+func StringLikeContainsFoldTm[T ~string](tm typemap.String[T], substr string) Matcher[T] {
+	return stringContainsFoldImpl(tm, "match.StringLikeContainsFoldTm", substr)
+}
+
+func StringLikeContainsFold[T ~string](substr string) Matcher[T] {
+	tm := typemap.ForStringLike[T]{
+		StringFunc: DefaultString[T],
+	}
+	return stringContainsFoldImpl(tm, "match.StringLikeContainsFold", substr)
+}
+
+func StringContainsFold(substr string) Matcher[string] {
+	tm := typemap.ForString{
+		StringFunc: DefaultString[string],
+	}
+	return stringContainsFoldImpl(tm, "match.StringContainsFold", substr)
+}
+
+func stringHasPrefixFoldImpl[T ~string](tm typemap.String[T], name string, prefix string) Matcher[T] {
+	folded := foldString(prefix)
+	return stringPredicateImpl(tm, name, fmt.Sprintf("got has prefix %q ignoring case", prefix), func(got string) bool {
+		return strings.HasPrefix(foldString(got), folded)
+	})
+}
+
+func StringLikeHasPrefixFoldTm[T ~string](tm typemap.String[T], prefix string) Matcher[T] {
+	return stringHasPrefixFoldImpl(tm, "match.StringLikeHasPrefixFoldTm", prefix)
+}
+
+func StringLikeHasPrefixFold[T ~string](prefix string) Matcher[T] {
+	tm := typemap.ForStringLike[T]{
+		StringFunc: DefaultString[T],
+	}
+	return stringHasPrefixFoldImpl(tm, "match.StringLikeHasPrefixFold", prefix)
+}
+
+func StringHasPrefixFold(prefix string) Matcher[string] {
+	tm := typemap.ForString{
+		StringFunc: DefaultString[string],
+	}
+	return stringHasPrefixFoldImpl(tm, "match.StringHasPrefixFold", prefix)
+}
+
+func stringHasSuffixFoldImpl[T ~string](tm typemap.String[T], name string, suffix string) Matcher[T] {
+	folded := foldString(suffix)
+	return stringPredicateImpl(tm, name, fmt.Sprintf("got has suffix %q ignoring case", suffix), func(got string) bool {
+		return strings.HasSuffix(foldString(got), folded)
+	})
+}
+
+func StringLikeHasSuffixFoldTm[T ~string](tm typemap.String[T], suffix string) Matcher[T] {
+	return stringHasSuffixFoldImpl(tm, "match.StringLikeHasSuffixFoldTm", suffix)
+}
+
+func StringLikeHasSuffixFold[T ~string](suffix string) Matcher[T] {
+	tm := typemap.ForStringLike[T]{
+		StringFunc: DefaultString[T],
+	}
+	return stringHasSuffixFoldImpl(tm, "match.StringLikeHasSuffixFold", suffix)
+}
+
+func StringHasSuffixFold(suffix string) Matcher[string] {
+	tm := typemap.ForString{
+		StringFunc: DefaultString[string],
+	}
+	return stringHasSuffixFoldImpl(tm, "match.StringHasSuffixFold", suffix)
+}
+
+func stringEqualNormalizedImpl[T ~string](tm typemap.String[T], name string, want string) Matcher[T] {
+	normalized := norm.NFC.String(want)
+	return stringPredicateImpl(tm, name, fmt.Sprintf("got is canonically equivalent to %q", want), func(got string) bool {
+		return norm.NFC.String(got) == normalized
+	})
+}
+
+func StringLikeEqualNormalizedTm[T ~string](tm typemap.String[T], want string) Matcher[T] {
+	return stringEqualNormalizedImpl(tm, "match.StringLikeEqualNormalizedTm", want)
+}
+
+func StringLikeEqualNormalized[T ~string](want string) Matcher[T] {
+	tm := typemap.ForStringLike[T]{
+		StringFunc: DefaultString[T],
+	}
+	return stringEqualNormalizedImpl(tm, "match.StringLikeEqualNormalized", want)
+}
+
+func StringEqualNormalized(want string) Matcher[string] {
+	tm := typemap.ForString{
+		StringFunc: DefaultString[string],
+	}
+	return stringEqualNormalizedImpl(tm, "match.StringEqualNormalized", want)
+}
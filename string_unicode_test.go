@@ -0,0 +1,285 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/krelinga/go-match"
+	"github.com/krelinga/go-typemap"
+)
+
+type label string
+
+func TestStringEqualFold(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "equal_ignoring_case",
+			matcher: match.StringEqualFold("Hello"),
+			value:   "hELLO",
+			want:    true,
+		},
+		{
+			name:    "full_case_folding",
+			matcher: match.StringEqualFold("STRASSE"),
+			value:   "straße",
+			want:    true,
+		},
+		{
+			name:    "normalization_insensitive",
+			matcher: match.StringEqualFold("CAF\u00c9"),
+			value:   "cafe\u0301",
+			want:    true,
+		},
+		{
+			name:    "not_equal",
+			matcher: match.StringEqualFold("Hello"),
+			value:   "help",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestStringContainsFold(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "contains",
+			matcher: match.StringContainsFold("WORLD"),
+			value:   "hello world",
+			want:    true,
+		},
+		{
+			name:    "does_not_contain",
+			matcher: match.StringContainsFold("moon"),
+			value:   "hello world",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestStringHasPrefixFold(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "has_prefix",
+			matcher: match.StringHasPrefixFold("HELLO"),
+			value:   "hello world",
+			want:    true,
+		},
+		{
+			name:    "no_prefix",
+			matcher: match.StringHasPrefixFold("WORLD"),
+			value:   "hello world",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestStringHasSuffixFold(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "has_suffix",
+			matcher: match.StringHasSuffixFold("WORLD"),
+			value:   "hello world",
+			want:    true,
+		},
+		{
+			name:    "no_suffix",
+			matcher: match.StringHasSuffixFold("HELLO"),
+			value:   "hello world",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestStringEqualNormalized(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "nfc_vs_nfd",
+			matcher: match.StringEqualNormalized("caf\u00e9"),
+			value:   "cafe\u0301",
+			want:    true,
+		},
+		{
+			name:    "case_still_matters",
+			matcher: match.StringEqualNormalized("CAF\u00c9"),
+			value:   "cafe\u0301",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestStringLikeUnicode(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[label]
+		value   label
+		want    bool
+	}{
+		{
+			name:    "equal_fold",
+			matcher: match.StringLikeEqualFold[label]("Hello"),
+			value:   "HELLO",
+			want:    true,
+		},
+		{
+			name:    "contains_fold",
+			matcher: match.StringLikeContainsFold[label]("X"),
+			value:   "abc",
+			want:    false,
+		},
+		{
+			name:    "has_prefix_fold",
+			matcher: match.StringLikeHasPrefixFold[label]("A"),
+			value:   "abc",
+			want:    true,
+		},
+		{
+			name:    "has_suffix_fold",
+			matcher: match.StringLikeHasSuffixFold[label]("C"),
+			value:   "abc",
+			want:    true,
+		},
+		{
+			name:    "equal_normalized",
+			matcher: match.StringLikeEqualNormalized[label]("caf\u00e9"),
+			value:   "cafe\u0301",
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestStringLikeUnicodeTm(t *testing.T) {
+	goldie := newGoldie(t)
+	tm := typemap.StringFunc[label](func(l label) string {
+		return "label(" + string(l) + ")"
+	})
+	tests := []struct {
+		name    string
+		matcher match.Matcher[label]
+		value   label
+		want    bool
+	}{
+		{
+			name:    "equal_fold",
+			matcher: match.StringLikeEqualFoldTm(tm, "Hello"),
+			value:   "goodbye",
+			want:    false,
+		},
+		{
+			name:    "contains_fold",
+			matcher: match.StringLikeContainsFoldTm(tm, "X"),
+			value:   "abc",
+			want:    false,
+		},
+		{
+			name:    "has_prefix_fold",
+			matcher: match.StringLikeHasPrefixFoldTm(tm, "B"),
+			value:   "abc",
+			want:    false,
+		},
+		{
+			name:    "has_suffix_fold",
+			matcher: match.StringLikeHasSuffixFoldTm(tm, "B"),
+			value:   "abc",
+			want:    false,
+		},
+		{
+			name:    "equal_normalized",
+			matcher: match.StringLikeEqualNormalizedTm(tm, "abd"),
+			value:   "abc",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
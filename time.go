@@ -0,0 +1,115 @@
+package match
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+func timeString(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+func signedDuration(d time.Duration) string {
+	if d >= 0 {
+		return "+" + d.String()
+	}
+	return d.String()
+}
+
+func timeCompareImpl(name, op string, other time.Time, pred func(got time.Time) bool) Matcher[time.Time] {
+	return nodeMatcher[time.Time]{
+		matches: pred,
+		describe: func(got time.Time) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  pred(got),
+				Name:     name,
+				Expected: fmt.Sprintf("got %s %s", op, timeString(other)),
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("got == %s (difference: %s)", timeString(got), signedDuration(got.Sub(other)))
+			}
+			return node
+		},
+	}
+}
+
+func TimeBefore(other time.Time) Matcher[time.Time] {
+	return timeCompareImpl("match.TimeBefore", "<", other, func(got time.Time) bool {
+		return got.Before(other)
+	})
+}
+
+func TimeAfter(other time.Time) Matcher[time.Time] {
+	return timeCompareImpl("match.TimeAfter", ">", other, func(got time.Time) bool {
+		return got.After(other)
+	})
+}
+
+func TimeEqualInstant(want time.Time) Matcher[time.Time] {
+	return timeCompareImpl("match.TimeEqualInstant", "==", want, func(got time.Time) bool {
+		return got.Equal(want)
+	})
+}
+
+func TimeWithin(want time.Time, tolerance time.Duration) Matcher[time.Time] {
+	return nodeMatcher[time.Time]{
+		describe: func(got time.Time) matchfmt.Node {
+			diff := got.Sub(want)
+			node := matchfmt.Node{
+				Matched:  diff.Abs() <= tolerance,
+				Name:     "match.TimeWithin",
+				Expected: fmt.Sprintf("|got - %s| <= %s", timeString(want), tolerance),
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("got == %s (difference: %s)", timeString(got), signedDuration(diff))
+			}
+			return node
+		},
+	}
+}
+
+// TimeInLocation matches times whose location has the same name as loc and
+// whose zone, that is the zone abbreviation and offset in effect at got, is
+// the one loc gives for got.  Locations are compared this way rather than by
+// pointer so that loading the same location twice gives equal locations,
+// while fixed zones that share a name but not an offset do not.
+func TimeInLocation(loc *time.Location) Matcher[time.Time] {
+	return nodeMatcher[time.Time]{
+		describe: func(got time.Time) matchfmt.Node {
+			gotName, gotOffset := got.Zone()
+			wantName, wantOffset := got.In(loc).Zone()
+			node := matchfmt.Node{
+				Matched: got.Location().String() == loc.String() &&
+					gotName == wantName && gotOffset == wantOffset,
+				Name:     "match.TimeInLocation",
+				Expected: fmt.Sprintf("got in location %q", loc),
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("got == %s in location %q", timeString(got), got.Location())
+				if got.Location().String() == loc.String() {
+					const zone = "MST -07:00"
+					node.Actual += fmt.Sprintf(" with zone %s, want zone %s", got.Format(zone), got.In(loc).Format(zone))
+				}
+			}
+			return node
+		},
+	}
+}
+
+func DurationBetween(lo, hi time.Duration) Matcher[time.Duration] {
+	return nodeMatcher[time.Duration]{
+		describe: func(got time.Duration) matchfmt.Node {
+			node := matchfmt.Node{
+				Matched:  lo <= got && got <= hi,
+				Name:     "match.DurationBetween",
+				Expected: fmt.Sprintf("%s <= got <= %s", lo, hi),
+			}
+			if !node.Matched {
+				node.Actual = fmt.Sprintf("got == %s", got)
+			}
+			return node
+		},
+	}
+}
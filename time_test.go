@@ -0,0 +1,223 @@
+package match_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/krelinga/go-match"
+)
+
+var (
+	noon    = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	newYork = time.FixedZone("America/New_York", -5*60*60)
+)
+
+func TestTimeBefore(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[time.Time]
+		value   time.Time
+		want    bool
+	}{
+		{
+			name:    "before",
+			matcher: match.TimeBefore(noon),
+			value:   noon.Add(-time.Minute),
+			want:    true,
+		},
+		{
+			name:    "equal",
+			matcher: match.TimeBefore(noon),
+			value:   noon,
+			want:    false,
+		},
+		{
+			name:    "after",
+			matcher: match.TimeBefore(noon),
+			value:   noon.Add(90 * time.Second),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestTimeAfter(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[time.Time]
+		value   time.Time
+		want    bool
+	}{
+		{
+			name:    "after",
+			matcher: match.TimeAfter(noon),
+			value:   noon.Add(time.Minute),
+			want:    true,
+		},
+		{
+			name:    "before",
+			matcher: match.TimeAfter(noon),
+			value:   noon.Add(-2 * time.Hour),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestTimeWithin(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[time.Time]
+		value   time.Time
+		want    bool
+	}{
+		{
+			name:    "within_tolerance",
+			matcher: match.TimeWithin(noon, time.Second),
+			value:   noon.Add(-500 * time.Millisecond),
+			want:    true,
+		},
+		{
+			name:    "outside_tolerance",
+			matcher: match.TimeWithin(noon, time.Second),
+			value:   noon.Add(-1500 * time.Millisecond),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestTimeInLocation(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[time.Time]
+		value   time.Time
+		want    bool
+	}{
+		{
+			name:    "same_location",
+			matcher: match.TimeInLocation(newYork),
+			value:   noon.In(newYork),
+			want:    true,
+		},
+		{
+			name:    "different_location",
+			matcher: match.TimeInLocation(newYork),
+			value:   noon,
+			want:    false,
+		},
+		{
+			name:    "same_name_different_offset",
+			matcher: match.TimeInLocation(time.FixedZone("X", 3600)),
+			value:   noon.In(time.FixedZone("X", 0)),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestTimeEqualInstant(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[time.Time]
+		value   time.Time
+		want    bool
+	}{
+		{
+			name:    "same_instant_different_location",
+			matcher: match.TimeEqualInstant(noon),
+			value:   noon.In(newYork),
+			want:    true,
+		},
+		{
+			name:    "different_instant",
+			matcher: match.TimeEqualInstant(noon),
+			value:   noon.Add(time.Nanosecond),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestDurationBetween(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[time.Duration]
+		value   time.Duration
+		want    bool
+	}{
+		{
+			name:    "in_range",
+			matcher: match.DurationBetween(time.Second, time.Minute),
+			value:   30 * time.Second,
+			want:    true,
+		},
+		{
+			name:    "at_upper_bound",
+			matcher: match.DurationBetween(time.Second, time.Minute),
+			value:   time.Minute,
+			want:    true,
+		},
+		{
+			name:    "below_range",
+			matcher: match.DurationBetween(time.Second, time.Minute),
+			value:   time.Millisecond,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
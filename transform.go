@@ -0,0 +1,56 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+// Transform matches values for which f(got) matches matcher.  f may have side
+// effects, such as reading from got, so Check calls it only once.
+func Transform[T, U any](name string, f func(T) U, matcher Matcher[U]) Matcher[T] {
+	return nodeMatcher[T]{
+		impure: true,
+		matches: func(got T) bool {
+			return Matches(f(got), matcher)
+		},
+		describe: func(got T) matchfmt.Node {
+			inner := Describe(f(got), matcher)
+			return matchfmt.Node{
+				Matched: inner.Matched,
+				Name:    "match.Transform",
+				Details: []matchfmt.Detail{{
+					Label: name + ":",
+					Node:  &inner,
+				}},
+			}
+		},
+	}
+}
+
+// TransformErr is like Transform but does not match if f returns an error.
+func TransformErr[T, U any](name string, f func(T) (U, error), matcher Matcher[U]) Matcher[T] {
+	return nodeMatcher[T]{
+		impure: true,
+		matches: func(got T) bool {
+			value, err := f(got)
+			return err == nil && Matches(value, matcher)
+		},
+		describe: func(got T) matchfmt.Node {
+			node := matchfmt.Node{Name: "match.TransformErr"}
+			value, err := f(got)
+			if err != nil {
+				node.Expected = fmt.Sprintf("%s succeeds", name)
+				node.Actual = fmt.Sprintf("%s failed: %s", name, describeError(err))
+				return node
+			}
+			inner := Describe(value, matcher)
+			node.Matched = inner.Matched
+			node.Details = []matchfmt.Detail{{
+				Label: name + ":",
+				Node:  &inner,
+			}}
+			return node
+		},
+	}
+}
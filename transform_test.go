@@ -0,0 +1,89 @@
+package match_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/krelinga/go-match"
+)
+
+func TestTransform(t *testing.T) {
+	goldie := newGoldie(t)
+	wordCount := func(s string) int {
+		return len(strings.Fields(s))
+	}
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "matches",
+			matcher: match.Transform("word count", wordCount, match.Equal(3)),
+			value:   "one two three",
+			want:    true,
+		},
+		{
+			name:    "does_not_match",
+			matcher: match.Transform("word count", wordCount, match.Equal(3)),
+			value:   "one two",
+			want:    false,
+		},
+		{
+			name: "nested",
+			matcher: match.Transform("fields", strings.Fields,
+				match.SliceElementsAre(match.Equal("a"), match.Transform("upper", strings.ToUpper, match.Equal("C")))),
+			value: "a b",
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
+
+func TestTransformErr(t *testing.T) {
+	goldie := newGoldie(t)
+	tests := []struct {
+		name    string
+		matcher match.Matcher[string]
+		value   string
+		want    bool
+	}{
+		{
+			name:    "matches",
+			matcher: match.TransformErr("strconv.Atoi", strconv.Atoi, match.GreaterThan(10)),
+			value:   "42",
+			want:    true,
+		},
+		{
+			name:    "does_not_match",
+			matcher: match.TransformErr("strconv.Atoi", strconv.Atoi, match.GreaterThan(10)),
+			value:   "7",
+			want:    false,
+		},
+		{
+			name:    "error",
+			matcher: match.TransformErr("strconv.Atoi", strconv.Atoi, match.GreaterThan(10)),
+			value:   "forty-two",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotExplanation := tt.matcher.Match(tt.value)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			goldie.Assert(t, tt.name, []byte(gotExplanation))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package typeless
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+type HasPrefixMatcher struct {
+	Prefix string
+	Fmt    FmtFunc
+}
+
+func HasPrefix(prefix string) HasPrefixMatcher {
+	return HasPrefixMatcher{Prefix: prefix, Fmt: DefaultFmt}
+}
+
+func (m HasPrefixMatcher) Match(got any) (Matched, Explanation, error) {
+	return affixMatch(got, m.Prefix, m.Fmt, "match.HasPrefix", "starts with", strings.HasPrefix)
+}
+
+type HasSuffixMatcher struct {
+	Suffix string
+	Fmt    FmtFunc
+}
+
+func HasSuffix(suffix string) HasSuffixMatcher {
+	return HasSuffixMatcher{Suffix: suffix, Fmt: DefaultFmt}
+}
+
+func (m HasSuffixMatcher) Match(got any) (Matched, Explanation, error) {
+	return affixMatch(got, m.Suffix, m.Fmt, "match.HasSuffix", "ends with", strings.HasSuffix)
+}
+
+func affixMatch(got any, affix string, fmtFunc FmtFunc, name, verb string, pred func(s, affix string) bool) (Matched, Explanation, error) {
+	val := reflect.ValueOf(got)
+	if !val.IsValid() || val.Kind() != reflect.String {
+		return false, "", Error(ErrType, fmt.Sprintf("%s requires a string, got %T", name, got))
+	}
+	matched := pred(val.String(), affix)
+	gotStr, err := fmtFunc(got)
+	if err != nil {
+		return false, "", err
+	}
+	affixStr, err := fmtFunc(affix)
+	if err != nil {
+		return false, "", err
+	}
+	var detail string
+	expected := fmt.Sprintf("got %s %s", verb, affixStr)
+	if matched {
+		detail = expected
+	} else {
+		actual := fmt.Sprintf("got == %s", gotStr)
+		detail = matchfmt.ActualVsExpected(actual, expected)
+	}
+	explanation := matchfmt.Explain(matched, name, detail)
+	return Matched(matched), Explanation(explanation), nil
+}
@@ -0,0 +1,69 @@
+package typeless
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+type ContainsMatcher struct {
+	Val  any
+	Comp CompFunc
+	Fmt  FmtFunc
+}
+
+// Contains matches strings that contain val as a substring, and arrays and
+// slices with an element equal to val according to Comp.
+func Contains[T comparable](val T) ContainsMatcher {
+	return ContainsMatcher{
+		Val:  val,
+		Comp: DefaultComp[T],
+		Fmt:  DefaultFmt,
+	}
+}
+
+func (m ContainsMatcher) Match(got any) (Matched, Explanation, error) {
+	val := reflect.ValueOf(got)
+	if !val.IsValid() {
+		return false, "", Error(ErrValue, "got value is invalid")
+	}
+	var matched bool
+	switch val.Kind() {
+	case reflect.String:
+		substr, ok := m.Val.(string)
+		if !ok {
+			return false, "", Error(ErrType, fmt.Sprintf("Contains on a string requires a string value, got %T", m.Val))
+		}
+		matched = strings.Contains(val.String(), substr)
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < val.Len() && !matched; i++ {
+			equal, err := m.Comp(val.Index(i).Interface(), m.Val)
+			if err != nil {
+				return false, "", err
+			}
+			matched = equal
+		}
+	default:
+		return false, "", Error(ErrType, fmt.Sprintf("Contains matcher requires string, array, or slice.  Got type %q, which is kind %s", val.Type(), val.Kind()))
+	}
+	gotStr, err := m.Fmt(got)
+	if err != nil {
+		return false, "", err
+	}
+	valStr, err := m.Fmt(m.Val)
+	if err != nil {
+		return false, "", err
+	}
+	var detail string
+	expected := fmt.Sprintf("got contains %s", valStr)
+	if matched {
+		detail = expected
+	} else {
+		actual := fmt.Sprintf("got == %s", gotStr)
+		detail = matchfmt.ActualVsExpected(actual, expected)
+	}
+	explanation := matchfmt.Explain(matched, "match.Contains", detail)
+	return Matched(matched), Explanation(explanation), nil
+}
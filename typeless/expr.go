@@ -0,0 +1,623 @@
+package typeless
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Expr is a parsed matcher expression.  Expressions are a compact textual
+// form of specs:
+//
+//	len > 3 && (contains "foo" || prefix "bar")
+//
+// A comparison operator followed by a literal (== != < <= > >=) is the spec
+// eq, ne, lt, le, gt or ge.  A name followed by a literal passes the literal
+// as its argument; a name followed by another expression, as in "len > 3",
+// passes that expression as a nested spec; and a name on its own, such as
+// "empty", passes true.  "!" negates, "&&" binds tighter than "||", and
+// parentheses group.  Literals are Go-style double-quoted strings, numbers,
+// true, false and null.
+//
+// String renders an Expr back to expression syntax, and parsing the result
+// gives an equivalent Expr.
+type Expr interface {
+	String() string
+	column() int
+}
+
+type exprList struct {
+	col   int
+	op    string // "&&" or "||"
+	items []Expr
+}
+
+type exprNot struct {
+	col int
+	x   Expr
+}
+
+type exprCompare struct {
+	col int
+	op  string
+	lit exprLiteral
+}
+
+// exprCall applies a named matcher.  At most one of lit and arg is set; if
+// neither is, the matcher is passed true.
+type exprCall struct {
+	col  int
+	name string
+	lit  *exprLiteral
+	arg  Expr
+}
+
+type exprLiteral struct {
+	col int
+	// value is a string, int, float64, bool or nil.
+	value any
+}
+
+// ExprError reports a problem in an expression.  Column counts bytes from 1.
+type ExprError struct {
+	Column  int
+	Message string
+}
+
+func (e *ExprError) Error() string {
+	return fmt.Sprintf("expression error at column %d: %s", e.Column, e.Message)
+}
+
+func exprErrorf(col int, format string, args ...any) error {
+	return &ExprError{Column: col, Message: fmt.Sprintf(format, args...)}
+}
+
+var compareSpecNames = map[string]string{
+	"==": "eq",
+	"!=": "ne",
+	"<":  "lt",
+	"<=": "le",
+	">":  "gt",
+	">=": "ge",
+}
+
+func (e *exprList) column() int    { return e.col }
+func (e *exprNot) column() int     { return e.col }
+func (e *exprCompare) column() int { return e.col }
+func (e *exprCall) column() int    { return e.col }
+
+// operand renders x, adding parentheses if it is a list so that the
+// enclosing expression parses back to the same tree.
+func operand(x Expr) string {
+	if _, ok := x.(*exprList); ok {
+		return "(" + x.String() + ")"
+	}
+	return x.String()
+}
+
+func (e *exprList) String() string {
+	parts := make([]string, len(e.items))
+	for i, item := range e.items {
+		parts[i] = operand(item)
+	}
+	return strings.Join(parts, " "+e.op+" ")
+}
+
+func (e *exprNot) String() string {
+	// A space keeps "!" from fusing with a comparison operator, as in "!==".
+	if _, ok := e.x.(*exprCompare); ok {
+		return "! " + e.x.String()
+	}
+	return "!" + operand(e.x)
+}
+
+func (e *exprCompare) String() string {
+	return e.op + " " + e.lit.String()
+}
+
+func (e *exprCall) String() string {
+	switch {
+	case e.lit != nil:
+		return e.name + " " + e.lit.String()
+	case e.arg != nil:
+		return e.name + " " + operand(e.arg)
+	default:
+		return e.name
+	}
+}
+
+func (l exprLiteral) String() string {
+	switch v := l.value.(type) {
+	case string:
+		return strconv.Quote(v)
+	case float64:
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+		return s
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// specValue converts the literal to the form Load decodes JSON into.
+func (l exprLiteral) specValue() any {
+	switch v := l.value.(type) {
+	case int:
+		return json.Number(strconv.Itoa(v))
+	case float64:
+		return json.Number(strconv.FormatFloat(v, 'g', -1, 64))
+	default:
+		return v
+	}
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokLiteral
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	col  int
+	text string
+	lit  exprLiteral
+}
+
+type exprLexer struct {
+	src string
+	pos int
+}
+
+func isIdentByte(c byte, first bool) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || (!first && '0' <= c && c <= '9')
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func (l *exprLexer) next() (token, error) {
+	for l.pos < len(l.src) && strings.IndexByte(" \t\r\n", l.src[l.pos]) >= 0 {
+		l.pos++
+	}
+	start := l.pos
+	col := start + 1
+	if l.pos == len(l.src) {
+		return token{kind: tokEOF, col: col}, nil
+	}
+	c := l.src[l.pos]
+	switch {
+	case isIdentByte(c, true):
+		for l.pos < len(l.src) && isIdentByte(l.src[l.pos], false) {
+			l.pos++
+		}
+		text := l.src[start:l.pos]
+		switch text {
+		case "true", "false":
+			return token{kind: tokLiteral, col: col, text: text, lit: exprLiteral{col: col, value: text == "true"}}, nil
+		case "null":
+			return token{kind: tokLiteral, col: col, text: text, lit: exprLiteral{col: col}}, nil
+		}
+		return token{kind: tokIdent, col: col, text: text}, nil
+	case isDigit(c) || (c == '-' && l.pos+1 < len(l.src) && isDigit(l.src[l.pos+1])):
+		return l.number()
+	case c == '"':
+		return l.string()
+	}
+	for _, op := range []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")"} {
+		if strings.HasPrefix(l.src[l.pos:], op) {
+			l.pos += len(op)
+			return token{kind: tokOp, col: col, text: op}, nil
+		}
+	}
+	return token{}, exprErrorf(col, "unexpected character %q", c)
+}
+
+func (l *exprLexer) number() (token, error) {
+	start := l.pos
+	col := start + 1
+	isFloat := false
+	if l.src[l.pos] == '-' {
+		l.pos++
+	}
+	for ; l.pos < len(l.src); l.pos++ {
+		c := l.src[l.pos]
+		if c == '.' || c == 'e' || c == 'E' {
+			isFloat = true
+		} else if !isDigit(c) && !((c == '+' || c == '-') && strings.IndexByte("eE", l.src[l.pos-1]) >= 0) {
+			break
+		}
+	}
+	text := l.src[start:l.pos]
+	if !isFloat {
+		if i, err := strconv.Atoi(text); err == nil {
+			return token{kind: tokLiteral, col: col, text: text, lit: exprLiteral{col: col, value: i}}, nil
+		}
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return token{}, exprErrorf(col, "invalid number %q", text)
+	}
+	return token{kind: tokLiteral, col: col, text: text, lit: exprLiteral{col: col, value: f}}, nil
+}
+
+func (l *exprLexer) string() (token, error) {
+	start := l.pos
+	col := start + 1
+	l.pos++
+	for l.pos < len(l.src) {
+		switch l.src[l.pos] {
+		case '\\':
+			l.pos += 2
+			continue
+		case '"':
+			l.pos++
+			text := l.src[start:l.pos]
+			s, err := strconv.Unquote(text)
+			if err != nil {
+				return token{}, exprErrorf(col, "invalid string %s", text)
+			}
+			return token{kind: tokLiteral, col: col, text: text, lit: exprLiteral{col: col, value: s}}, nil
+		}
+		l.pos++
+	}
+	return token{}, exprErrorf(col, "unterminated string")
+}
+
+type exprParser struct {
+	lex exprLexer
+	tok token
+}
+
+func (p *exprParser) advance() error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *exprParser) describe(tok token) string {
+	if tok.kind == tokEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(tok.text)
+}
+
+func (p *exprParser) parseList(op string, parseItem func() (Expr, error)) (Expr, error) {
+	first, err := parseItem()
+	if err != nil {
+		return nil, err
+	}
+	items := []Expr{first}
+	for p.tok.kind == tokOp && p.tok.text == op {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		item, err := parseItem()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	if len(items) == 1 {
+		return first, nil
+	}
+	return &exprList{col: first.column(), op: op, items: items}, nil
+}
+
+func (p *exprParser) parseOr() (Expr, error) {
+	return p.parseList("||", p.parseAnd)
+}
+
+func (p *exprParser) parseAnd() (Expr, error) {
+	return p.parseList("&&", p.parseUnary)
+}
+
+// startsUnary reports whether tok can begin a unary expression.
+func startsUnary(tok token) bool {
+	switch tok.kind {
+	case tokIdent:
+		return true
+	case tokOp:
+		_, isCompare := compareSpecNames[tok.text]
+		return isCompare || tok.text == "!" || tok.text == "("
+	}
+	return false
+}
+
+func (p *exprParser) parseUnary() (Expr, error) {
+	tok := p.tok
+	switch {
+	case tok.kind == tokOp && tok.text == "!":
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &exprNot{col: tok.col, x: x}, nil
+	case tok.kind == tokOp && tok.text == "(":
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokOp || p.tok.text != ")" {
+			return nil, exprErrorf(p.tok.col, "expected \")\" to close \"(\" at column %d, got %s", tok.col, p.describe(p.tok))
+		}
+		return x, p.advance()
+	case tok.kind == tokOp && compareSpecNames[tok.text] != "":
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokLiteral {
+			return nil, exprErrorf(p.tok.col, "expected a literal after %q, got %s", tok.text, p.describe(p.tok))
+		}
+		lit := p.tok.lit
+		return &exprCompare{col: tok.col, op: tok.text, lit: lit}, p.advance()
+	case tok.kind == tokIdent:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		call := &exprCall{col: tok.col, name: tok.text}
+		switch {
+		case p.tok.kind == tokLiteral:
+			lit := p.tok.lit
+			call.lit = &lit
+			return call, p.advance()
+		case startsUnary(p.tok):
+			arg, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+			call.arg = arg
+		}
+		return call, nil
+	}
+	return nil, exprErrorf(tok.col, "expected a matcher, got %s", p.describe(tok))
+}
+
+// ParseExpr parses a matcher expression.
+func ParseExpr(src string) (Expr, error) {
+	p := &exprParser{lex: exprLexer{src: src}}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	x, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, exprErrorf(p.tok.col, "unexpected %s", p.describe(p.tok))
+	}
+	return x, nil
+}
+
+// exprSpec converts x to the spec tree that Load would decode, recording the
+// column of each spec path so that spec errors can be located in the source.
+func exprSpec(x Expr, path string, columns map[string]int) any {
+	columns[path] = x.column()
+	switch x := x.(type) {
+	case *exprList:
+		name := "allOf"
+		if x.op == "||" {
+			name = "anyOf"
+		}
+		listPath := SpecPath(path, name)
+		columns[listPath] = x.col
+		items := make([]any, len(x.items))
+		for i, item := range x.items {
+			items[i] = exprSpec(item, SpecIndexPath(listPath, i), columns)
+		}
+		return map[string]any{name: items}
+	case *exprNot:
+		return map[string]any{"not": exprSpec(x.x, SpecPath(path, "not"), columns)}
+	case *exprCompare:
+		name := compareSpecNames[x.op]
+		columns[SpecPath(path, name)] = x.lit.col
+		return map[string]any{name: x.lit.specValue()}
+	case *exprCall:
+		argPath := SpecPath(path, x.name)
+		switch {
+		case x.lit != nil:
+			columns[argPath] = x.lit.col
+			return map[string]any{x.name: x.lit.specValue()}
+		case x.arg != nil:
+			return map[string]any{x.name: exprSpec(x.arg, argPath, columns)}
+		default:
+			return map[string]any{x.name: true}
+		}
+	}
+	panic(fmt.Sprintf("typeless: unexpected expression node %T", x))
+}
+
+// ExprMatcher is a Matcher compiled from an expression.  Its String method
+// renders the expression.
+type ExprMatcher struct {
+	Expr    Expr
+	Matcher Matcher
+}
+
+func (m ExprMatcher) Match(got any) (Matched, Explanation, error) {
+	return m.Matcher.Match(got)
+}
+
+func (m ExprMatcher) String() string {
+	return m.Expr.String()
+}
+
+// CompileExpr parses src and builds its Matcher using the registry's named
+// matchers.
+func (r *SpecRegistry) CompileExpr(src string) (ExprMatcher, error) {
+	x, err := ParseExpr(src)
+	if err != nil {
+		return ExprMatcher{}, err
+	}
+	columns := map[string]int{}
+	m, err := r.Build("$", exprSpec(x, "$", columns))
+	if err != nil {
+		if specErr, ok := err.(*SpecError); ok {
+			return ExprMatcher{}, exprErrorf(specColumn(specErr.Path, columns), "%s", specErr.Message)
+		}
+		return ExprMatcher{}, err
+	}
+	return ExprMatcher{Expr: x, Matcher: m}, nil
+}
+
+// CompileExpr builds a Matcher from an expression using the built-in
+// matchers of NewSpecRegistry.
+func CompileExpr(src string) (ExprMatcher, error) {
+	return NewSpecRegistry().CompileExpr(src)
+}
+
+// specColumn finds the column recorded for path, or for its nearest
+// ancestor.
+func specColumn(path string, columns map[string]int) int {
+	for {
+		if col, ok := columns[path]; ok {
+			return col
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i <= 0 {
+			return 1
+		}
+		path = path[:i]
+	}
+}
+
+// FormatExpr renders m in expression syntax.  It supports matchers returned
+// by CompileExpr and the built-in matchers of NewSpecRegistry, however they
+// were constructed; for any other matcher it returns an error.
+func FormatExpr(m Matcher) (string, error) {
+	x, err := matcherExpr(m)
+	if err != nil {
+		return "", err
+	}
+	return x.String(), nil
+}
+
+func matcherExpr(m Matcher) (Expr, error) {
+	switch m := m.(type) {
+	case ExprMatcher:
+		return m.Expr, nil
+	case allOfMatcher:
+		return listExpr("&&", m.matchers)
+	case anyOfMatcher:
+		return listExpr("||", m.matchers)
+	case notMatcher:
+		x, err := matcherExpr(m.matcher)
+		if err != nil {
+			return nil, err
+		}
+		return &exprNot{x: x}, nil
+	case lenMatcher:
+		return callExpr("len", m.matcher)
+	case KeysMatcher:
+		return callExpr("keys", m.Inner)
+	case EqualMatcher:
+		return compareExpr("==", m.Val)
+	case NotEqualMatcher:
+		return compareExpr("!=", m.Val)
+	case LessThanMatcher:
+		return compareExpr("<", m.Val)
+	case LessThanOrEqualMatcher:
+		return compareExpr("<=", m.Val)
+	case GreaterThanMatcher:
+		return compareExpr(">", m.Val)
+	case GreaterThanOrEqualMatcher:
+		return compareExpr(">=", m.Val)
+	case ContainsMatcher:
+		return literalCallExpr("contains", m.Val)
+	case HasPrefixMatcher:
+		return literalCallExpr("prefix", m.Prefix)
+	case HasSuffixMatcher:
+		return literalCallExpr("suffix", m.Suffix)
+	case NilMatcher:
+		return &exprCall{name: "nil"}, nil
+	case emptyMatcher:
+		return &exprCall{name: "empty"}, nil
+	}
+	return nil, Error(ErrType, fmt.Sprintf("%T has no expression syntax", m))
+}
+
+func listExpr(op string, matchers []Matcher) (Expr, error) {
+	if len(matchers) == 0 {
+		return nil, Error(ErrValue, fmt.Sprintf("an empty list of matchers has no expression syntax for %q", op))
+	}
+	items := make([]Expr, len(matchers))
+	for i, m := range matchers {
+		x, err := matcherExpr(m)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = x
+	}
+	return &exprList{op: op, items: items}, nil
+}
+
+func callExpr(name string, inner Matcher) (Expr, error) {
+	arg, err := matcherExpr(inner)
+	if err != nil {
+		return nil, err
+	}
+	return &exprCall{name: name, arg: arg}, nil
+}
+
+func compareExpr(op string, v any) (Expr, error) {
+	lit, err := literalExpr(v)
+	if err != nil {
+		return nil, err
+	}
+	return &exprCompare{op: op, lit: lit}, nil
+}
+
+func literalCallExpr(name string, v any) (Expr, error) {
+	lit, err := literalExpr(v)
+	if err != nil {
+		return nil, err
+	}
+	return &exprCall{name: name, lit: &lit}, nil
+}
+
+func literalExpr(v any) (exprLiteral, error) {
+	if v == nil {
+		return exprLiteral{}, nil
+	}
+	val := reflect.ValueOf(v)
+	switch val.Kind() {
+	case reflect.String:
+		return exprLiteral{value: val.String()}, nil
+	case reflect.Bool:
+		return exprLiteral{value: val.Bool()}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return exprLiteral{value: int(val.Int())}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if val.Uint() <= math.MaxInt {
+			return exprLiteral{value: int(val.Uint())}, nil
+		}
+		return exprLiteral{value: float64(val.Uint())}, nil
+	case reflect.Float32, reflect.Float64:
+		f := val.Float()
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return exprLiteral{}, Error(ErrValue, fmt.Sprintf("%v has no expression syntax", f))
+		}
+		return exprLiteral{value: f}, nil
+	}
+	return exprLiteral{}, Error(ErrType, fmt.Sprintf("literal of type %T has no expression syntax", v))
+}
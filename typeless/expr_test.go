@@ -0,0 +1,325 @@
+package typeless_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/krelinga/go-match/typeless"
+)
+
+func TestCompileExpr(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		got  any
+		want typeless.Matched
+	}{
+		{
+			name: "len and contains",
+			expr: `len > 3 && (contains "foo" || prefix "bar")`,
+			got:  "xfoox",
+			want: true,
+		},
+		{
+			name: "len and prefix",
+			expr: `len > 3 && (contains "foo" || prefix "bar")`,
+			got:  "barn",
+			want: true,
+		},
+		{
+			name: "too short",
+			expr: `len > 3 && (contains "foo" || prefix "bar")`,
+			got:  "bar",
+			want: false,
+		},
+		{
+			name: "and binds tighter than or",
+			expr: `== 1 || == 2 && == 3`,
+			got:  1,
+			want: true,
+		},
+		{
+			name: "negation",
+			expr: `!(< 0 || > 10)`,
+			got:  5,
+			want: true,
+		},
+		{
+			name: "numbers compare across types",
+			expr: `>= -1.5 && <= 2e3`,
+			got:  int16(-1),
+			want: true,
+		},
+		{
+			name: "flags",
+			expr: `!nil && !empty`,
+			got:  []int{1},
+			want: true,
+		},
+		{
+			name: "flag argument",
+			expr: `empty false`,
+			got:  "",
+			want: false,
+		},
+		{
+			name: "null literal",
+			expr: `== null`,
+			got:  map[string]int(nil),
+			want: true,
+		},
+		{
+			name: "keys",
+			expr: `keys contains "b"`,
+			got:  map[string]int{"a": 1, "b": 2},
+			want: true,
+		},
+		{
+			name: "escaped string",
+			expr: `suffix "\"\n"`,
+			got:  "say \"\n",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := typeless.CompileExpr(tt.expr)
+			if err != nil {
+				t.Fatalf("CompileExpr() error: %v", err)
+			}
+			matched, explanation, err := matcher.Match(tt.got)
+			if err != nil {
+				t.Fatalf("Match() error: %v", err)
+			}
+			if matched != tt.want {
+				t.Errorf("Match() = %v, want %v\n%s", matched, tt.want, explanation)
+			}
+		})
+	}
+}
+
+func TestCompileExprErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{
+			name: "empty",
+			expr: ``,
+			want: `expression error at column 1: expected a matcher, got end of expression`,
+		},
+		{
+			name: "unclosed paren",
+			expr: `len > 3 && (contains "foo"`,
+			want: `expression error at column 27: expected ")" to close "(" at column 12, got end of expression`,
+		},
+		{
+			name: "missing literal",
+			expr: `len > && empty`,
+			want: `expression error at column 7: expected a literal after ">", got "&&"`,
+		},
+		{
+			name: "unexpected character",
+			expr: `len > 3 & empty`,
+			want: `expression error at column 9: unexpected character '&'`,
+		},
+		{
+			name: "unterminated string",
+			expr: `prefix "abc`,
+			want: `expression error at column 8: unterminated string`,
+		},
+		{
+			name: "trailing tokens",
+			expr: `empty )`,
+			want: `expression error at column 7: unexpected ")"`,
+		},
+		{
+			name: "unknown matcher",
+			expr: `len > 3 && (contains "foo" || bogus "bar")`,
+			want: `expression error at column 31: unknown matcher "bogus"`,
+		},
+		{
+			name: "wrong argument type",
+			expr: `empty || prefix 3`,
+			want: `expression error at column 17: expected a string, got a number`,
+		},
+		{
+			name: "literal where spec expected",
+			expr: `len 3`,
+			want: `expression error at column 5: expected a spec object, got a number`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := typeless.CompileExpr(tt.expr)
+			if err == nil {
+				t.Fatal("CompileExpr() succeeded, want error")
+			}
+			var exprErr *typeless.ExprError
+			if !errors.As(err, &exprErr) {
+				t.Errorf("CompileExpr() error is %T, want *typeless.ExprError", err)
+			}
+			if err.Error() != tt.want {
+				t.Errorf("CompileExpr() error =\n%s\nwant\n%s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestExprRoundTrip(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: `len > 3 && (contains "foo" || prefix "bar")`},
+		{expr: `len>3&&(contains "foo"||prefix "bar")`, want: `len > 3 && (contains "foo" || prefix "bar")`},
+		{expr: `(== 1 || == 2) && != 3`},
+		{expr: `== 1 || (== 2 && != 3)`},
+		{expr: `== 1 || == 2 && != 3`, want: `== 1 || (== 2 && != 3)`},
+		{expr: `!(nil || empty)`},
+		{expr: `!!nil`},
+		{expr: `! == 3`},
+		{expr: `! != "a" && !(< 1 || >= 2)`},
+		{expr: `!! <= 2.5`},
+		{expr: `not (== 1 || == 2)`},
+		{expr: `len len >= 2`},
+		{expr: `== 2.50`, want: `== 2.5`},
+		{expr: `< 1e6`, want: `< 1e+06`},
+		{expr: `< 3.0`},
+		{expr: `== "tab\there"`},
+		{expr: `empty false`},
+		{expr: `== null || == true`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			want := tt.want
+			if want == "" {
+				want = tt.expr
+			}
+			x, err := typeless.ParseExpr(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseExpr() error: %v", err)
+			}
+			if got := x.String(); got != want {
+				t.Errorf("String() = %s, want %s", got, want)
+			}
+			again, err := typeless.ParseExpr(x.String())
+			if err != nil {
+				t.Fatalf("ParseExpr(String()) error: %v", err)
+			}
+			if again.String() != x.String() {
+				t.Errorf("String() is not stable: %s then %s", x, again)
+			}
+		})
+	}
+}
+
+func TestFormatExpr(t *testing.T) {
+	compiled, err := typeless.CompileExpr(`len>3&&(contains "foo"||prefix "bar")`)
+	if err != nil {
+		t.Fatalf("CompileExpr() error: %v", err)
+	}
+	tests := []struct {
+		name    string
+		matcher typeless.Matcher
+		want    string
+	}{
+		{
+			name:    "compiled",
+			matcher: compiled,
+			want:    `len > 3 && (contains "foo" || prefix "bar")`,
+		},
+		{
+			name: "built in Go",
+			matcher: typeless.AllOf(
+				typeless.Len(typeless.GreaterThan(3)),
+				typeless.AnyOf(typeless.Contains("foo"), typeless.HasPrefix("bar")),
+			),
+			want: `len > 3 && (contains "foo" || prefix "bar")`,
+		},
+		{
+			name:    "not and flags",
+			matcher: typeless.Not(typeless.AnyOf(typeless.Nil(), typeless.Empty())),
+			want:    `!(nil || empty)`,
+		},
+		{
+			name: "comparisons",
+			matcher: typeless.AllOf(
+				typeless.Equal(uint8(1)),
+				typeless.NotEqual(float32(0.5)),
+				typeless.LessThanOrEqual(2.0),
+				typeless.GreaterThanOrEqual("a"),
+			),
+			want: `== 1 && != 0.5 && <= 2.0 && >= "a"`,
+		},
+		{
+			name: "negated comparisons",
+			matcher: typeless.AllOf(
+				typeless.Not(typeless.Equal(3)),
+				typeless.Not(typeless.LessThan("b")),
+				typeless.Not(typeless.Not(typeless.GreaterThan(1.5))),
+			),
+			want: `! == 3 && ! < "b" && !! > 1.5`,
+		},
+		{
+			name:    "keys",
+			matcher: typeless.Keys(typeless.Contains(true)),
+			want:    `keys contains true`,
+		},
+		{
+			name:    "nested compiled",
+			matcher: typeless.Not(compiled),
+			want:    `!(len > 3 && (contains "foo" || prefix "bar"))`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := typeless.FormatExpr(tt.matcher)
+			if err != nil {
+				t.Fatalf("FormatExpr() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatExpr() = %s, want %s", got, tt.want)
+			}
+			if _, err := typeless.CompileExpr(got); err != nil {
+				t.Errorf("CompileExpr(FormatExpr()) error: %v", err)
+			}
+		})
+	}
+
+	unsupported := typeless.FuncMatcher(func(got any) (typeless.Matched, typeless.Explanation, error) {
+		return true, "", nil
+	})
+	if _, err := typeless.FormatExpr(typeless.Len(unsupported)); !errors.Is(err, typeless.ErrType) {
+		t.Errorf("FormatExpr() of a FuncMatcher error = %v, want ErrType", err)
+	}
+	if _, err := typeless.FormatExpr(typeless.AllOf()); !errors.Is(err, typeless.ErrValue) {
+		t.Errorf("FormatExpr() of an empty AllOf error = %v, want ErrValue", err)
+	}
+}
+
+func TestSpecRegistryCompileExpr(t *testing.T) {
+	r := typeless.NewSpecRegistry()
+	r.Register("even", func(r *typeless.SpecRegistry, path string, arg any) (typeless.Matcher, error) {
+		return typeless.FuncMatcher(func(got any) (typeless.Matched, typeless.Explanation, error) {
+			return got.(int)%2 == 0, "even", nil
+		}), nil
+	})
+	matcher, err := r.CompileExpr(`even && > 2`)
+	if err != nil {
+		t.Fatalf("CompileExpr() error: %v", err)
+	}
+	if matched, _, err := matcher.Match(4); err != nil || !matched {
+		t.Errorf("Match(4) = %v, %v; want true, nil", matched, err)
+	}
+	if matched, _, err := matcher.Match(2); err != nil || matched {
+		t.Errorf("Match(2) = %v, %v; want false, nil", matched, err)
+	}
+	if got := matcher.String(); got != `even && > 2` {
+		t.Errorf("String() = %s", got)
+	}
+	if _, err := typeless.CompileExpr(`even`); err == nil {
+		t.Error("CompileExpr() with the default registry accepted a custom matcher")
+	}
+}
@@ -0,0 +1,67 @@
+package typeless
+
+import (
+	"fmt"
+
+	"github.com/krelinga/go-match/matchfmt"
+)
+
+type allOfMatcher struct {
+	matchers []Matcher
+}
+
+func AllOf(matchers ...Matcher) Matcher {
+	return allOfMatcher{matchers: matchers}
+}
+
+func (m allOfMatcher) Match(got any) (Matched, Explanation, error) {
+	matched := Matched(true)
+	details := make([]string, 0, len(m.matchers))
+	for i, matcher := range m.matchers {
+		innerMatched, explanation, err := matcher.Match(got)
+		if err != nil {
+			return false, "", err
+		}
+		matched = matched && innerMatched
+		details = append(details, fmt.Sprintf("matcher %d:", i), matchfmt.Indent(string(explanation)))
+	}
+	return matched, Explanation(matchfmt.Explain(bool(matched), "match.AllOf", details...)), nil
+}
+
+type anyOfMatcher struct {
+	matchers []Matcher
+}
+
+func AnyOf(matchers ...Matcher) Matcher {
+	return anyOfMatcher{matchers: matchers}
+}
+
+func (m anyOfMatcher) Match(got any) (Matched, Explanation, error) {
+	matched := Matched(false)
+	details := make([]string, 0, len(m.matchers))
+	for i, matcher := range m.matchers {
+		innerMatched, explanation, err := matcher.Match(got)
+		if err != nil {
+			return false, "", err
+		}
+		matched = matched || innerMatched
+		details = append(details, fmt.Sprintf("matcher %d:", i), matchfmt.Indent(string(explanation)))
+	}
+	return matched, Explanation(matchfmt.Explain(bool(matched), "match.AnyOf", details...)), nil
+}
+
+type notMatcher struct {
+	matcher Matcher
+}
+
+func Not(matcher Matcher) Matcher {
+	return notMatcher{matcher: matcher}
+}
+
+func (m notMatcher) Match(got any) (Matched, Explanation, error) {
+	matched, explanation, err := m.matcher.Match(got)
+	if err != nil {
+		return false, "", err
+	}
+	return !matched, Explanation(matchfmt.Explain(!bool(matched), "match.Not", string(explanation))), nil
+}
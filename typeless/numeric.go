@@ -0,0 +1,55 @@
+package typeless
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+)
+
+// numericRat converts any integer or floating-point value to an exact
+// rational so that values of different numeric types can be compared.
+func numericRat(v any, which string) (*big.Rat, error) {
+	val := reflect.ValueOf(v)
+	if !val.IsValid() {
+		return nil, Error(ErrValue, fmt.Sprintf("for %s, expected a number, got nil", which))
+	}
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(val.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Rat).SetUint64(val.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		f := val.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, Error(ErrValue, fmt.Sprintf("for %s, expected a finite number, got %v", which, f))
+		}
+		return new(big.Rat).SetFloat64(f), nil
+	default:
+		return nil, Error(ErrType, fmt.Sprintf("for %s, expected a number, got %T", which, v))
+	}
+}
+
+// NumericComp is a CompFunc that compares numbers by value regardless of
+// their Go types, so int(3), uint8(3) and float64(3) are all equal.
+func NumericComp(a, b any) (bool, error) {
+	order, err := NumericOrd(a, b)
+	if err != nil {
+		return false, err
+	}
+	return order == 0, nil
+}
+
+// NumericOrd is an OrdFunc that orders numbers by value regardless of their
+// Go types.
+func NumericOrd(a, b any) (int, error) {
+	aRat, err := numericRat(a, "a")
+	if err != nil {
+		return 0, err
+	}
+	bRat, err := numericRat(b, "b")
+	if err != nil {
+		return 0, err
+	}
+	return aRat.Cmp(bRat), nil
+}
@@ -36,27 +36,85 @@ func LessThan[T cmp.Ordered](val T) LessThanMatcher {
 }
 
 func (m LessThanMatcher) Match(got any) (Matched, Explanation, error) {
-	compare, err := m.Ord(got, m.Val)
+	return orderMatch(got, m.Val, m.Ord, m.Fmt, "match.LessThanMatcher", "<", func(c int) bool { return c < 0 })
+}
+
+type LessThanOrEqualMatcher struct {
+	Val any
+	Ord OrdFunc
+	Fmt FmtFunc
+}
+
+func LessThanOrEqual[T cmp.Ordered](val T) LessThanOrEqualMatcher {
+	return LessThanOrEqualMatcher{
+		Val: val,
+		Ord: DefaultOrd[T],
+		Fmt: DefaultFmt,
+	}
+}
+
+func (m LessThanOrEqualMatcher) Match(got any) (Matched, Explanation, error) {
+	return orderMatch(got, m.Val, m.Ord, m.Fmt, "match.LessThanOrEqualMatcher", "<=", func(c int) bool { return c <= 0 })
+}
+
+type GreaterThanMatcher struct {
+	Val any
+	Ord OrdFunc
+	Fmt FmtFunc
+}
+
+func GreaterThan[T cmp.Ordered](val T) GreaterThanMatcher {
+	return GreaterThanMatcher{
+		Val: val,
+		Ord: DefaultOrd[T],
+		Fmt: DefaultFmt,
+	}
+}
+
+func (m GreaterThanMatcher) Match(got any) (Matched, Explanation, error) {
+	return orderMatch(got, m.Val, m.Ord, m.Fmt, "match.GreaterThanMatcher", ">", func(c int) bool { return c > 0 })
+}
+
+type GreaterThanOrEqualMatcher struct {
+	Val any
+	Ord OrdFunc
+	Fmt FmtFunc
+}
+
+func GreaterThanOrEqual[T cmp.Ordered](val T) GreaterThanOrEqualMatcher {
+	return GreaterThanOrEqualMatcher{
+		Val: val,
+		Ord: DefaultOrd[T],
+		Fmt: DefaultFmt,
+	}
+}
+
+func (m GreaterThanOrEqualMatcher) Match(got any) (Matched, Explanation, error) {
+	return orderMatch(got, m.Val, m.Ord, m.Fmt, "match.GreaterThanOrEqualMatcher", ">=", func(c int) bool { return c >= 0 })
+}
+
+func orderMatch(got, val any, ord OrdFunc, fmtFunc FmtFunc, name, op string, pred func(compare int) bool) (Matched, Explanation, error) {
+	compare, err := ord(got, val)
 	if err != nil {
 		return false, "", err
 	}
-	lessThan := compare < 0
-	gotStr, err := m.Fmt(got)
+	matched := pred(compare)
+	gotStr, err := fmtFunc(got)
 	if err != nil {
 		return false, "", err
 	}
-	valStr, err := m.Fmt(m.Val)
+	valStr, err := fmtFunc(val)
 	if err != nil {
 		return false, "", err
 	}
 	var detail string
-	expected := fmt.Sprintf("got < %s", valStr)
-	if lessThan {
+	expected := fmt.Sprintf("got %s %s", op, valStr)
+	if matched {
 		detail = expected
 	} else {
 		actual := fmt.Sprintf("got == %s", gotStr)
 		detail = matchfmt.ActualVsExpected(actual, expected)
 	}
-	explanation := matchfmt.Explain(lessThan, "match.LessThanMatcher", detail)
-	return Matched(lessThan), Explanation(explanation), nil
-}
\ No newline at end of file
+	explanation := matchfmt.Explain(matched, name, detail)
+	return Matched(matched), Explanation(explanation), nil
+}
@@ -0,0 +1,325 @@
+package typeless
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// SpecError reports a problem in a matcher spec document.  Path locates the
+// problem using JSONPath syntax, for example "$.allOf[1].len".
+type SpecError struct {
+	Path    string
+	Message string
+}
+
+func (e *SpecError) Error() string {
+	return fmt.Sprintf("spec error at %s: %s", e.Path, e.Message)
+}
+
+func specErrorf(path, format string, args ...any) error {
+	return &SpecError{Path: path, Message: fmt.Sprintf(format, args...)}
+}
+
+// SpecBuilder builds a Matcher from the argument of a named matcher in a
+// spec.  path is the location of arg in the document; builders should use it
+// when reporting errors, and pass extended paths to r.Build for nested specs.
+type SpecBuilder func(r *SpecRegistry, path string, arg any) (Matcher, error)
+
+// SpecRegistry maps matcher names to the builders that construct them.  A
+// spec is a JSON object with exactly one key, the matcher name, whose value
+// is the matcher's argument:
+//
+//	{"allOf": [{"len": {"gt": 3}}, {"contains": "x"}]}
+//
+// Arguments are decoded as by encoding/json, except that numbers are
+// json.Number values; use SpecLiteral to convert them.
+type SpecRegistry struct {
+	builders map[string]SpecBuilder
+}
+
+// NewSpecRegistry returns a registry with the built-in matchers:
+//
+//   - allOf, anyOf: an array of specs
+//   - not, len, keys: a spec
+//   - eq, ne, lt, le, gt, ge, contains: a literal; numbers compare by value
+//     with NumericComp and NumericOrd
+//   - prefix, suffix: a string
+//   - nil, empty: true, or false for the negation
+func NewSpecRegistry() *SpecRegistry {
+	r := &SpecRegistry{builders: map[string]SpecBuilder{}}
+	r.Register("allOf", buildList(AllOf))
+	r.Register("anyOf", buildList(AnyOf))
+	r.Register("not", buildWrapped(Not))
+	r.Register("len", buildWrapped(Len))
+	r.Register("keys", buildWrapped(func(m Matcher) Matcher { return Keys(m) }))
+	r.Register("eq", buildEqual)
+	r.Register("ne", buildNotEqual)
+	r.Register("lt", buildOrder(func(v any, ord OrdFunc) Matcher { return LessThanMatcher{Val: v, Ord: ord, Fmt: DefaultFmt} }))
+	r.Register("le", buildOrder(func(v any, ord OrdFunc) Matcher { return LessThanOrEqualMatcher{Val: v, Ord: ord, Fmt: DefaultFmt} }))
+	r.Register("gt", buildOrder(func(v any, ord OrdFunc) Matcher { return GreaterThanMatcher{Val: v, Ord: ord, Fmt: DefaultFmt} }))
+	r.Register("ge", buildOrder(func(v any, ord OrdFunc) Matcher { return GreaterThanOrEqualMatcher{Val: v, Ord: ord, Fmt: DefaultFmt} }))
+	r.Register("contains", buildContains)
+	r.Register("prefix", buildString(func(s string) Matcher { return HasPrefix(s) }))
+	r.Register("suffix", buildString(func(s string) Matcher { return HasSuffix(s) }))
+	r.Register("nil", buildFlag(Nil()))
+	r.Register("empty", buildFlag(Empty()))
+	return r
+}
+
+// Register adds a named matcher.  It panics if name is already registered.
+func (r *SpecRegistry) Register(name string, builder SpecBuilder) {
+	if _, ok := r.builders[name]; ok {
+		panic(fmt.Sprintf("typeless: spec matcher %q already registered", name))
+	}
+	r.builders[name] = builder
+}
+
+// Load parses a JSON spec document and builds its Matcher.
+func (r *SpecRegistry) Load(data []byte) (Matcher, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var spec any
+	if err := dec.Decode(&spec); err != nil {
+		return nil, syntaxError(data, err)
+	}
+	end := dec.InputOffset()
+	if _, err := dec.Token(); err != io.EOF {
+		rest := bytes.TrimLeft(data[end:], " \t\r\n")
+		return nil, specErrorf("$", "unexpected data after spec at %s", position(data, int64(len(data)-len(rest))))
+	}
+	return r.Build("$", spec)
+}
+
+// Build builds the Matcher for a decoded spec found at path.
+func (r *SpecRegistry) Build(path string, spec any) (Matcher, error) {
+	obj, ok := spec.(map[string]any)
+	if !ok {
+		return nil, specErrorf(path, "expected a spec object, got %s", specKind(spec))
+	}
+	if len(obj) != 1 {
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		return nil, specErrorf(path, "spec object must have exactly one key, got %d: %q", len(obj), keys)
+	}
+	for name, arg := range obj {
+		builder, ok := r.builders[name]
+		if !ok {
+			return nil, specErrorf(path, "unknown matcher %q", name)
+		}
+		return builder(r, SpecPath(path, name), arg)
+	}
+	panic("unreachable")
+}
+
+// LoadSpec builds a Matcher from a JSON spec document using the built-in
+// matchers of NewSpecRegistry.
+func LoadSpec(data []byte) (Matcher, error) {
+	return NewSpecRegistry().Load(data)
+}
+
+var specIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SpecPath extends path with an object key.
+func SpecPath(path, key string) string {
+	if specIdentifier.MatchString(key) {
+		return path + "." + key
+	}
+	return fmt.Sprintf("%s[%s]", path, quoteSpecKey(key))
+}
+
+func quoteSpecKey(key string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(key) + "'"
+}
+
+// SpecIndexPath extends path with an array index.
+func SpecIndexPath(path string, index int) string {
+	return fmt.Sprintf("%s[%d]", path, index)
+}
+
+// SpecLiteral converts a decoded scalar argument to a Go value: strings,
+// bools and nil are returned as is, and numbers become an int if they are
+// integers that fit, or a float64 otherwise.
+func SpecLiteral(path string, arg any) (any, error) {
+	switch v := arg.(type) {
+	case nil, string, bool:
+		return v, nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil && int64(int(i)) == i {
+			return int(i), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, specErrorf(path, "number %s out of range", v)
+		}
+		return f, nil
+	default:
+		return nil, specErrorf(path, "expected a string, number, bool or null, got %s", specKind(arg))
+	}
+}
+
+func specKind(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "an array"
+	case string:
+		return "a string"
+	case bool:
+		return "a bool"
+	case json.Number:
+		return "a number"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// position describes the byte at offset in data as a line and column, both
+// counted from 1.
+func position(data []byte, offset int64) string {
+	offset = min(offset, int64(len(data)))
+	before := data[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := int(offset) - bytes.LastIndexByte(before, '\n')
+	return fmt.Sprintf("line %d, column %d", line, column)
+}
+
+func syntaxError(data []byte, err error) error {
+	var syntax *json.SyntaxError
+	if errors.As(err, &syntax) {
+		return specErrorf("$", "invalid JSON at %s: %v", position(data, syntax.Offset-1), err)
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return specErrorf("$", "unexpected end of JSON")
+	}
+	return specErrorf("$", "invalid JSON: %v", err)
+}
+
+func buildList(combine func(...Matcher) Matcher) SpecBuilder {
+	return func(r *SpecRegistry, path string, arg any) (Matcher, error) {
+		specs, ok := arg.([]any)
+		if !ok {
+			return nil, specErrorf(path, "expected an array of specs, got %s", specKind(arg))
+		}
+		matchers := make([]Matcher, len(specs))
+		for i, spec := range specs {
+			m, err := r.Build(SpecIndexPath(path, i), spec)
+			if err != nil {
+				return nil, err
+			}
+			matchers[i] = m
+		}
+		return combine(matchers...), nil
+	}
+}
+
+func buildWrapped(wrap func(Matcher) Matcher) SpecBuilder {
+	return func(r *SpecRegistry, path string, arg any) (Matcher, error) {
+		m, err := r.Build(path, arg)
+		if err != nil {
+			return nil, err
+		}
+		return wrap(m), nil
+	}
+}
+
+func buildFlag(m Matcher) SpecBuilder {
+	return func(r *SpecRegistry, path string, arg any) (Matcher, error) {
+		flag, ok := arg.(bool)
+		if !ok {
+			return nil, specErrorf(path, "expected true or false, got %s", specKind(arg))
+		}
+		if !flag {
+			return Not(m), nil
+		}
+		return m, nil
+	}
+}
+
+func literalComp(path string, arg any) (any, CompFunc, error) {
+	v, err := SpecLiteral(path, arg)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch v.(type) {
+	case string:
+		return v, DefaultComp[string], nil
+	case bool:
+		return v, DefaultComp[bool], nil
+	case nil:
+		return nil, nil, nil
+	default:
+		return v, NumericComp, nil
+	}
+}
+
+func buildEqual(r *SpecRegistry, path string, arg any) (Matcher, error) {
+	v, comp, err := literalComp(path, arg)
+	if err != nil {
+		return nil, err
+	}
+	if comp == nil {
+		return Nil(), nil
+	}
+	return EqualMatcher{Val: v, Comp: comp, Fmt: DefaultFmt}, nil
+}
+
+func buildNotEqual(r *SpecRegistry, path string, arg any) (Matcher, error) {
+	v, comp, err := literalComp(path, arg)
+	if err != nil {
+		return nil, err
+	}
+	if comp == nil {
+		return Not(Nil()), nil
+	}
+	return NotEqualMatcher{Val: v, Comp: comp, Fmt: DefaultFmt}, nil
+}
+
+func buildOrder(build func(v any, ord OrdFunc) Matcher) SpecBuilder {
+	return func(r *SpecRegistry, path string, arg any) (Matcher, error) {
+		v, err := SpecLiteral(path, arg)
+		if err != nil {
+			return nil, err
+		}
+		switch v.(type) {
+		case string:
+			return build(v, DefaultOrd[string]), nil
+		case int, float64:
+			return build(v, NumericOrd), nil
+		default:
+			return nil, specErrorf(path, "expected a number or string, got %s", specKind(arg))
+		}
+	}
+}
+
+func buildContains(r *SpecRegistry, path string, arg any) (Matcher, error) {
+	v, comp, err := literalComp(path, arg)
+	if err != nil {
+		return nil, err
+	}
+	if comp == nil {
+		return nil, specErrorf(path, "expected a string, number or bool, got null")
+	}
+	return ContainsMatcher{Val: v, Comp: comp, Fmt: DefaultFmt}, nil
+}
+
+func buildString(build func(string) Matcher) SpecBuilder {
+	return func(r *SpecRegistry, path string, arg any) (Matcher, error) {
+		s, ok := arg.(string)
+		if !ok {
+			return nil, specErrorf(path, "expected a string, got %s", specKind(arg))
+		}
+		return build(s), nil
+	}
+}
@@ -0,0 +1,196 @@
+package typeless_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/krelinga/go-match/typeless"
+)
+
+func TestLoadSpec(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		got  any
+		want typeless.Matched
+	}{
+		{
+			name: "allOf len and contains",
+			spec: `{"allOf": [{"len": {"gt": 3}}, {"contains": "x"}]}`,
+			got:  "wxyz",
+			want: true,
+		},
+		{
+			name: "allOf fails",
+			spec: `{"allOf": [{"len": {"gt": 3}}, {"contains": "x"}]}`,
+			got:  "abcd",
+			want: false,
+		},
+		{
+			name: "numbers compare across types",
+			spec: `{"anyOf": [{"eq": 2.5}, {"eq": 3}]}`,
+			got:  uint8(3),
+			want: true,
+		},
+		{
+			name: "string ordering",
+			spec: `{"lt": "m"}`,
+			got:  "apple",
+			want: true,
+		},
+		{
+			name: "not",
+			spec: `{"not": {"le": 10}}`,
+			got:  11,
+			want: true,
+		},
+		{
+			name: "ne",
+			spec: `{"ne": true}`,
+			got:  false,
+			want: true,
+		},
+		{
+			name: "eq null",
+			spec: `{"eq": null}`,
+			got:  []int(nil),
+			want: true,
+		},
+		{
+			name: "keys",
+			spec: `{"keys": {"len": {"ge": 2}}}`,
+			got:  map[string]int{"a": 1},
+			want: false,
+		},
+		{
+			name: "slice contains",
+			spec: `{"contains": 2}`,
+			got:  []int64{1, 2, 3},
+			want: true,
+		},
+		{
+			name: "prefix and suffix",
+			spec: `{"allOf": [{"prefix": "ab"}, {"suffix": "yz"}]}`,
+			got:  "abxyz",
+			want: true,
+		},
+		{
+			name: "empty false",
+			spec: `{"empty": false}`,
+			got:  []int{},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := typeless.LoadSpec([]byte(tt.spec))
+			if err != nil {
+				t.Fatalf("LoadSpec() error: %v", err)
+			}
+			matched, explanation, err := matcher.Match(tt.got)
+			if err != nil {
+				t.Fatalf("Match() error: %v", err)
+			}
+			if matched != tt.want {
+				t.Errorf("Match() = %v, want %v\n%s", matched, tt.want, explanation)
+			}
+		})
+	}
+}
+
+func TestLoadSpecErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		want string
+	}{
+		{
+			name: "unknown matcher",
+			spec: `{"allOf": [{"len": {"gt": 3}}, {"bogus": 1}]}`,
+			want: `spec error at $.allOf[1]: unknown matcher "bogus"`,
+		},
+		{
+			name: "wrong argument type",
+			spec: `{"allOf": [{"len": {"gt": [3]}}]}`,
+			want: `spec error at $.allOf[0].len.gt: expected a string, number, bool or null, got an array`,
+		},
+		{
+			name: "too many keys",
+			spec: `{"not": {"eq": 1, "ne": 2}}`,
+			want: `spec error at $.not: spec object must have exactly one key, got 2: ["eq" "ne"]`,
+		},
+		{
+			name: "not an object",
+			spec: `{"anyOf": {"eq": 1}}`,
+			want: `spec error at $.anyOf: expected an array of specs, got an object`,
+		},
+		{
+			name: "bad flag",
+			spec: `{"nil": "yes"}`,
+			want: `spec error at $.nil: expected true or false, got a string`,
+		},
+		{
+			name: "syntax error",
+			spec: "{\n  \"eq\": 1,\n}",
+			want: `spec error at $: invalid JSON at line 3, column 1: invalid character '}' looking for beginning of object key string`,
+		},
+		{
+			name: "trailing data",
+			spec: `{"eq": 1} {}`,
+			want: `spec error at $: unexpected data after spec at line 1, column 11`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := typeless.LoadSpec([]byte(tt.spec))
+			if err == nil {
+				t.Fatal("LoadSpec() succeeded, want error")
+			}
+			var specErr *typeless.SpecError
+			if !errors.As(err, &specErr) {
+				t.Errorf("LoadSpec() error is %T, want *typeless.SpecError", err)
+			}
+			if err.Error() != tt.want {
+				t.Errorf("LoadSpec() error =\n%s\nwant\n%s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpecRegistry(t *testing.T) {
+	r := typeless.NewSpecRegistry()
+	r.Register("even", func(r *typeless.SpecRegistry, path string, arg any) (typeless.Matcher, error) {
+		if arg != true {
+			return nil, &typeless.SpecError{Path: path, Message: "expected true"}
+		}
+		return typeless.FuncMatcher(func(got any) (typeless.Matched, typeless.Explanation, error) {
+			n, ok := got.(int)
+			if !ok {
+				return false, "", typeless.Error(typeless.ErrType, "even requires an int")
+			}
+			return n%2 == 0, "even", nil
+		}), nil
+	})
+
+	matcher, err := r.Load([]byte(`{"allOf": [{"even": true}, {"gt": 2}]}`))
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if matched, _, err := matcher.Match(4); err != nil || !matched {
+		t.Errorf("Match(4) = %v, %v; want true, nil", matched, err)
+	}
+	if matched, _, err := matcher.Match(3); err != nil || matched {
+		t.Errorf("Match(3) = %v, %v; want false, nil", matched, err)
+	}
+
+	if _, err := r.Load([]byte(`{"even": 1}`)); err == nil || err.Error() != "spec error at $.even: expected true" {
+		t.Errorf("Load() error = %v", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Register() of a duplicate name did not panic")
+		}
+	}()
+	r.Register("eq", nil)
+}